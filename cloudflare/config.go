@@ -1,24 +1,169 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 )
 
 type Config struct {
-	Email   string
-	Token   string
-	Options []cloudflare.Option
+	Email          string
+	Token          string
+	APIToken       string
+	UserServiceKey string
+	Options        []cloudflare.Option
 }
 
 // Client() returns a new client for accessing cloudflare.
 func (c *Config) Client() (*cloudflare.API, error) {
-	client, err := cloudflare.New(c.Token, c.Email, c.Options...)
+	var client *cloudflare.API
+	var err error
+
+	switch {
+	case c.APIToken != "":
+		if c.Email != "" || c.Token != "" {
+			return nil, fmt.Errorf("api_token cannot be used together with email and token, only one authentication method may be configured")
+		}
+
+		client, err = newClientWithAPIToken(c.APIToken, c.Options...)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating new Cloudflare client: %s", err)
+		}
+		log.Printf("[INFO] Cloudflare Client configured with a scoped API token")
+	case c.Email == "" && c.Token == "":
+		if c.UserServiceKey == "" {
+			return nil, fmt.Errorf("no credentials configured: either api_token, email and token, or user_service_key must be set")
+		}
+
+		client, err = cloudflare.NewWithUserServiceKey(c.UserServiceKey, c.Options...)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating new Cloudflare client: %s", err)
+		}
+		log.Printf("[INFO] Cloudflare Client configured with a user service key")
+		return client, nil
+	case c.Email == "" || c.Token == "":
+		return nil, fmt.Errorf("email and token must both be set when authenticating with a global API key")
+	default:
+		client, err = cloudflare.New(c.Token, c.Email, c.Options...)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating new Cloudflare client: %s", err)
+		}
+		log.Printf("[INFO] Cloudflare Client configured for user: %s", c.Email)
+	}
+
+	// the user service key is only used by the endpoints that require it, such
+	// as the Origin CA certificates API
+	client.APIUserServiceKey = c.UserServiceKey
+
+	return client, nil
+}
+
+// providerClient is the meta handed to resources and data sources: the API
+// client together with state shared by all of them for the lifetime of the
+// provider.
+type providerClient struct {
+	*cloudflare.API
+
+	zones *zoneCache
+
+	// accountZones holds the zone caches of the accounts other than the
+	// provider's that clients returned by withAccount use.
+	accountZones *accountZoneCaches
+
+	// ctx cancels the client's requests, it is the provider's stop context
+	// unless the client was returned by withTimeout.
+	ctx       context.Context
+	transport http.RoundTripper
+}
+
+// newProviderClient wraps client so that its requests are sent through
+// transport and cancelled with ctx.
+func newProviderClient(client *cloudflare.API, ctx context.Context, transport http.RoundTripper) *providerClient {
+	cloudflare.HTTPClient(&http.Client{Transport: &contextTransport{ctx: ctx, base: transport}})(client)
+
+	return &providerClient{
+		API:          client,
+		zones:        newZoneCache(),
+		accountZones: newAccountZoneCaches(),
+		ctx:          ctx,
+		transport:    transport,
+	}
+}
+
+// withTimeout returns a copy of the client whose requests, including any
+// retries and rate limiting, are cancelled once timeout has elapsed or
+// Terraform is interrupted.
+func (c *providerClient) withTimeout(timeout time.Duration) (*providerClient, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(c.ctx, timeout)
+
+	api := *c.API
+	client := newProviderClient(&api, ctx, c.transport)
+	client.zones = c.zones
+	client.accountZones = c.accountZones
+
+	return client, cancel
+}
+
+// withAccount returns a copy of the client whose account-scoped requests are
+// made in accountID instead of the provider's account. The client itself is
+// returned if accountID is empty or already the client's account. The copy
+// uses the zone cache of accountID, which all copies for it share.
+func (c *providerClient) withAccount(accountID string) *providerClient {
+	if accountID == "" || accountID == c.OrganizationID {
+		return c
+	}
+
+	api := *c.API
+	api.OrganizationID = accountID
+
+	return &providerClient{
+		API:          &api,
+		zones:        c.accountZones.get(accountID),
+		accountZones: c.accountZones,
+		ctx:          c.ctx,
+		transport:    c.transport,
+	}
+}
+
+// ZoneIDByName resolves a zone name through the shared zone cache instead of
+// listing zones over the API on every call.
+func (c *providerClient) ZoneIDByName(zoneName string) (string, error) {
+	return c.zones.ZoneIDByName(c.API, zoneName)
+}
+
+// allZones returns every zone from the shared zone cache.
+func (c *providerClient) allZones() ([]cloudflare.Zone, error) {
+	return c.zones.List(c.API)
+}
+
+// newClientWithAPIToken returns a client that authenticates with a scoped
+// API token. The SDK only knows about global API keys and user service keys,
+// so the client is created without an auth type and the token is sent as a
+// Bearer Authorization header on every request instead.
+func newClientWithAPIToken(apiToken string, opts ...cloudflare.Option) (*cloudflare.API, error) {
+	headers := make(http.Header)
+	headers.Set("Authorization", "Bearer "+apiToken)
+
+	opts = append([]cloudflare.Option{cloudflare.Headers(headers)}, opts...)
+	client, err := cloudflare.NewWithUserServiceKey(apiToken, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("Error creating new Cloudflare client: %s", err)
+		return nil, err
 	}
-	log.Printf("[INFO] Cloudflare Client configured for user: %s", c.Email)
+	client.SetAuthType(0)
+
 	return client, nil
 }
+
+// usingBaseURL points the API client at a Cloudflare API endpoint other than
+// the public one.
+func usingBaseURL(baseURL string) cloudflare.Option {
+	return func(api *cloudflare.API) error {
+		api.BaseURL = strings.TrimSuffix(baseURL, "/")
+		return nil
+	}
+}
@@ -14,7 +14,11 @@ import (
 
 func TestAccCloudflareIPRanges(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			// the IP ranges are read from a fixed URL, not the API base URL
+			testAccPreCheckRealAPI(t)
+		},
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			resource.TestStep{
@@ -0,0 +1,51 @@
+package cloudflare
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCloudflareRecord() *schema.Resource {
+	s := dataSourceCloudflareRecordFilter()
+	for k, v := range dataSourceCloudflareRecordAttributes() {
+		if _, ok := s[k]; ok {
+			// filter arguments are also set from the record found
+			s[k].Computed = true
+			continue
+		}
+		s[k] = v
+	}
+	s["type"].Computed = true
+	s["proxied"].Computed = true
+
+	return &schema.Resource{
+		Read:   dataSourceCloudflareRecordRead,
+		Schema: s,
+	}
+}
+
+func dataSourceCloudflareRecordRead(d *schema.ResourceData, meta interface{}) error {
+	records, zoneID, err := dataSourceCloudflareRecordSearch(d, meta.(*providerClient))
+	if err != nil {
+		return err
+	}
+
+	switch len(records) {
+	case 0:
+		return fmt.Errorf("no DNS record in zone %q matches the given filter", zoneID)
+	case 1:
+	default:
+		return fmt.Errorf("%d DNS records in zone %q match the given filter, use the cloudflare_records data source to look up several records", len(records), zoneID)
+	}
+
+	record := records[0]
+	for k, v := range flattenDataSourceCloudflareRecord(record) {
+		d.Set(k, v)
+	}
+	d.Set("type", record.Type)
+	d.Set("proxied", record.Proxied)
+
+	d.SetId(record.ID)
+	return nil
+}
@@ -0,0 +1,232 @@
+package cloudflare
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCloudflareRecords() *schema.Resource {
+	recordSchema := dataSourceCloudflareRecordAttributes()
+	recordSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	recordSchema["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	recordSchema["proxied"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+
+	s := dataSourceCloudflareRecordFilter()
+	s["records"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: recordSchema,
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceCloudflareRecordsRead,
+		Schema: s,
+	}
+}
+
+// dataSourceCloudflareRecordFilter is the schema of the arguments the record
+// data sources filter the records of a zone by.
+func dataSourceCloudflareRecordFilter() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"zone_id"},
+		},
+		"zone_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"zone"},
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateRecordTypeName,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"proxied": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+// dataSourceCloudflareRecordAttributes is the schema of the attributes the
+// record data sources return for a record.
+func dataSourceCloudflareRecordAttributes() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"hostname": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ttl": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"priority": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"proxiable": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"created_on": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"modified_on": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for recordType := range recordDataFields {
+		s[strings.ToLower(recordType)] = dataSourceCloudflareRecordDataSchema(recordType)
+	}
+	return s
+}
+
+// dataSourceCloudflareRecordDataSchema is the computed counterpart of the
+// data block of recordType of the cloudflare_record resource.
+func dataSourceCloudflareRecordDataSchema(recordType string) *schema.Schema {
+	fields := make(map[string]*schema.Schema)
+	for _, field := range recordDataFields[recordType] {
+		fields[field.key] = &schema.Schema{
+			Type:     field.kind,
+			Computed: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func dataSourceCloudflareRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	records, zoneID, err := dataSourceCloudflareRecordSearch(d, meta.(*providerClient))
+	if err != nil {
+		return err
+	}
+
+	results := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		result := flattenDataSourceCloudflareRecord(record)
+		result["id"] = record.ID
+		result["type"] = record.Type
+		result["proxied"] = record.Proxied
+		results = append(results, result)
+	}
+
+	if err := d.Set("records", results); err != nil {
+		return fmt.Errorf("Error setting records: %s", err)
+	}
+
+	d.SetId(zoneID)
+	return nil
+}
+
+// dataSourceCloudflareRecordSearch lists the records of the zone matching the
+// filter arguments. The name may be given relative to the zone.
+func dataSourceCloudflareRecordSearch(d *schema.ResourceData, client *providerClient) ([]cloudflare.DNSRecord, string, error) {
+	zoneName := d.Get("zone").(string)
+	zoneID := d.Get("zone_id").(string)
+
+	var err error
+	switch {
+	case zoneID != "":
+	case zoneName != "":
+		zoneID, err = client.ZoneIDByName(zoneName)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error finding zone %q: %s", zoneName, err)
+		}
+	default:
+		return nil, "", fmt.Errorf("either 'zone' or 'zone_id' must be set")
+	}
+
+	if zoneName == "" {
+		zone, err := client.ZoneDetails(zoneID)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error finding zone %q: %s", zoneID, err)
+		}
+		zoneName = zone.Name
+	}
+	d.Set("zone", zoneName)
+	d.Set("zone_id", zoneID)
+
+	filter := cloudflare.DNSRecord{
+		Name:    recordFQDN(d.Get("name").(string), zoneName),
+		Type:    strings.ToUpper(d.Get("type").(string)),
+		Content: d.Get("value").(string),
+	}
+
+	log.Printf("[DEBUG] Looking up DNS records of zone %q matching %#v", zoneName, filter)
+
+	records, err := client.DNSRecords(zoneID, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error listing DNS records of zone %q: %s", zoneName, err)
+	}
+
+	proxied, proxiedOk := d.GetOkExists("proxied")
+	matched := records[:0]
+	for _, record := range records {
+		if proxiedOk && record.Proxied != proxied.(bool) {
+			continue
+		}
+		matched = append(matched, record)
+	}
+
+	return matched, zoneID, nil
+}
+
+func flattenDataSourceCloudflareRecord(record cloudflare.DNSRecord) map[string]interface{} {
+	result := map[string]interface{}{
+		"hostname":    record.Name,
+		"value":       canonicalRecordValue(record.Type, record.Content),
+		"ttl":         record.TTL,
+		"priority":    record.Priority,
+		"proxiable":   record.Proxiable,
+		"created_on":  record.CreatedOn.Format(time.RFC3339Nano),
+		"modified_on": record.ModifiedOn.Format(time.RFC3339Nano),
+	}
+	for recordType := range recordDataFields {
+		block := []interface{}{}
+		if recordType == record.Type {
+			block = flattenCloudflareRecordData(recordType, record.Data)
+		}
+		result[strings.ToLower(recordType)] = block
+	}
+	return result
+}
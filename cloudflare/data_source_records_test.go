@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCloudflareRecordsDataSource(t *testing.T) {
+	t.Parallel()
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := fmt.Sprintf("tf-acctest-ds-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordsDataSourceConfig(zoneName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cloudflare_records.all", "records.#", "2"),
+					resource.TestCheckResourceAttrPair("data.cloudflare_records.all", "zone_id", "cloudflare_record.origin_1", "zone_id"),
+
+					resource.TestCheckResourceAttr("data.cloudflare_records.unproxied", "records.#", "1"),
+					resource.TestCheckResourceAttrPair("data.cloudflare_records.unproxied", "records.0.id", "cloudflare_record.origin_1", "id"),
+					resource.TestCheckResourceAttr("data.cloudflare_records.unproxied", "records.0.value", "192.0.2.1"),
+					resource.TestCheckResourceAttr("data.cloudflare_records.unproxied", "records.0.type", "A"),
+					resource.TestCheckResourceAttr("data.cloudflare_records.unproxied", "records.0.proxied", "false"),
+					resource.TestCheckResourceAttr("data.cloudflare_records.unproxied", "records.0.hostname", name+"."+zoneName),
+					resource.TestCheckResourceAttr("data.cloudflare_records.unproxied", "records.0.srv.#", "0"),
+
+					resource.TestCheckResourceAttrPair("data.cloudflare_record.srv", "id", "cloudflare_record.srv", "id"),
+					resource.TestCheckResourceAttr("data.cloudflare_record.srv", "type", "SRV"),
+					resource.TestCheckResourceAttr("data.cloudflare_record.srv", "srv.0.port", "5060"),
+					resource.TestCheckResourceAttr("data.cloudflare_record.srv", "srv.0.target", "sip."+zoneName),
+					resource.TestCheckResourceAttr("data.cloudflare_record.srv", "zone", zoneName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecordDataSource_Ambiguous(t *testing.T) {
+	t.Parallel()
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := fmt.Sprintf("tf-acctest-ds-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordsDataSourceConfig(zoneName, name) + `
+data "cloudflare_record" "ambiguous" {
+  zone = "${cloudflare_record.origin_2.domain}"
+  name = "${cloudflare_record.origin_2.name}"
+}`,
+				ExpectError: regexp.MustCompile("2 DNS records in zone .* match the given filter"),
+			},
+			{
+				// leave a configuration that can be refreshed to destroy the records
+				Config: testAccCloudflareRecordsDataSourceConfig(zoneName, name),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRecordsDataSourceConfig(zoneName, name string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "origin_1" {
+  domain = "%[1]s"
+  name   = "%[2]s"
+  value  = "192.0.2.1"
+  type   = "A"
+}
+
+resource "cloudflare_record" "origin_2" {
+  domain  = "%[1]s"
+  name    = "%[2]s"
+  value   = "192.0.2.2"
+  type    = "A"
+  proxied = true
+
+  depends_on = ["cloudflare_record.origin_1"]
+}
+
+resource "cloudflare_record" "srv" {
+  domain = "%[1]s"
+  name   = "_sip._udp.%[2]s"
+  type   = "SRV"
+  srv {
+    service  = "_sip"
+    proto    = "_udp"
+    name     = "%[2]s"
+    priority = 10
+    weight   = 60
+    port     = 5060
+    target   = "sip.%[1]s"
+  }
+}
+
+data "cloudflare_records" "all" {
+  zone = "${cloudflare_record.origin_2.domain}"
+  name = "${cloudflare_record.origin_2.name}"
+  type = "a"
+}
+
+data "cloudflare_records" "unproxied" {
+  zone_id = "${cloudflare_record.origin_2.zone_id}"
+  name    = "${cloudflare_record.origin_2.hostname}"
+  proxied = false
+}
+
+data "cloudflare_record" "srv" {
+  zone_id = "${cloudflare_record.srv.zone_id}"
+  name    = "_sip._udp.%[2]s"
+  type    = "${cloudflare_record.srv.type}"
+}`, zoneName, name)
+}
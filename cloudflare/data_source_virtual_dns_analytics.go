@@ -0,0 +1,184 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// virtualDNSAnalyticsMetrics are the metrics a DNS analytics report of a
+// virtual DNS cluster can be made of.
+var virtualDNSAnalyticsMetrics = []string{
+	"queryCount",
+	"uncachedCount",
+	"staleCount",
+	"responseTimeAvg",
+	"responseTimeMedian",
+	"responseTime90th",
+	"responseTime99th",
+}
+
+// virtualDNSAnalyticsReport is a DNS analytics report of a virtual DNS
+// cluster. The SDK's VirtualDNSUserAnalytics neither takes dimensions nor
+// returns the rows of the report.
+type virtualDNSAnalyticsReport struct {
+	Totals map[string]float64 `json:"totals"`
+	Min    map[string]float64 `json:"min"`
+	Max    map[string]float64 `json:"max"`
+	Data   []struct {
+		Dimensions []string  `json:"dimensions"`
+		Metrics    []float64 `json:"metrics"`
+	} `json:"data"`
+}
+
+func dataSourceCloudflareVirtualDNSAnalytics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudflareVirtualDNSAnalyticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"virtual_dns_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metrics": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(virtualDNSAnalyticsMetrics, false),
+				},
+			},
+			"dimensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"filters": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"totals": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+			"min": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+			"max": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+			"rows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dimensions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"metrics": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareVirtualDNSAnalyticsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
+	virtualDNSID := d.Get("virtual_dns_id").(string)
+
+	query := url.Values{}
+	query.Set("metrics", strings.Join(expandInterfaceToStringList(d.Get("metrics")), ","))
+	if dimensions := expandInterfaceToStringList(d.Get("dimensions")); len(dimensions) > 0 {
+		query.Set("dimensions", strings.Join(dimensions, ","))
+	}
+	for _, key := range []string{"since", "until", "filters"} {
+		if v, ok := d.GetOk(key); ok {
+			query.Set(key, v.(string))
+		}
+	}
+	if limit, ok := d.GetOk("limit"); ok {
+		query.Set("limit", strconv.Itoa(limit.(int)))
+	}
+
+	path := "/user/virtual_dns/" + virtualDNSID
+	if client.OrganizationID != "" {
+		path = "/accounts/" + client.OrganizationID + "/virtual_dns/" + virtualDNSID
+	}
+	path += "/dns_analytics/report?" + query.Encode()
+
+	log.Printf("[DEBUG] Reading virtual DNS analytics: %s", path)
+
+	var report virtualDNSAnalyticsReport
+	res, err := client.Raw("GET", path, nil)
+	if err == nil {
+		err = json.Unmarshal(res, &report)
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading analytics of virtual DNS cluster %q: %s", virtualDNSID, err)
+	}
+
+	rows := make([]map[string]interface{}, 0, len(report.Data))
+	for _, row := range report.Data {
+		metrics := make([]interface{}, 0, len(row.Metrics))
+		for _, metric := range row.Metrics {
+			metrics = append(metrics, metric)
+		}
+		rows = append(rows, map[string]interface{}{
+			"dimensions": flattenStringList(row.Dimensions),
+			"metrics":    metrics,
+		})
+	}
+
+	d.Set("account_id", client.OrganizationID)
+	for key, metrics := range map[string]map[string]float64{"totals": report.Totals, "min": report.Min, "max": report.Max} {
+		if err := d.Set(key, metrics); err != nil {
+			return fmt.Errorf("Error setting %s: %s", key, err)
+		}
+	}
+	if err := d.Set("rows", rows); err != nil {
+		return fmt.Errorf("Error setting rows: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(path)))
+	return nil
+}
@@ -0,0 +1,81 @@
+package cloudflare
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCloudflareVirtualDNSAnalyticsDataSource(t *testing.T) {
+	t.Parallel()
+	rnd := acctest.RandString(10)
+	name := "data.cloudflare_virtual_dns_analytics.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareVirtualDNSAnalyticsConfig(rnd, `
+  metrics    = ["queryCount", "responseTimeAvg"]
+  dimensions = ["queryType"]
+  since      = "2019-10-01T00:00:00Z"
+  until      = "2019-10-02T00:00:00Z"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "virtual_dns_id", "cloudflare_virtual_dns.test", "id"),
+					resource.TestCheckResourceAttr(name, "totals.%", "2"),
+					resource.TestCheckResourceAttr(name, "totals.queryCount", "22000"),
+					resource.TestCheckResourceAttrSet(name, "totals.responseTimeAvg"),
+					resource.TestCheckResourceAttr(name, "max.queryCount", "12000"),
+					resource.TestCheckResourceAttr(name, "min.queryCount", "4000"),
+					resource.TestCheckResourceAttr(name, "rows.#", "3"),
+					resource.TestCheckResourceAttr(name, "rows.0.dimensions.#", "1"),
+					resource.TestCheckResourceAttr(name, "rows.0.dimensions.0", "A"),
+					resource.TestCheckResourceAttr(name, "rows.0.metrics.#", "2"),
+					resource.TestCheckResourceAttr(name, "rows.0.metrics.0", "12000"),
+					resource.TestCheckResourceAttr(name, "rows.0.metrics.1", "12.5"),
+				),
+			},
+			{
+				Config: testAccCloudflareVirtualDNSAnalyticsConfig(rnd, `
+  metrics    = ["queryCount"]
+  dimensions = ["queryType", "responseCode"]
+  limit      = 2`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rows.#", "2"),
+					resource.TestCheckResourceAttr(name, "rows.1.dimensions.0", "A"),
+					resource.TestCheckResourceAttr(name, "rows.1.dimensions.1", "NXDOMAIN"),
+				),
+			},
+			{
+				Config: testAccCloudflareVirtualDNSAnalyticsConfig(rnd, `
+  metrics = ["queryCount"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "totals.queryCount", "12000"),
+					resource.TestCheckResourceAttr(name, "rows.#", "0"),
+				),
+			},
+			{
+				Config: testAccCloudflareVirtualDNSAnalyticsConfig(rnd, `
+  metrics = ["queries"]`),
+				ExpectError: regexp.MustCompile(`expected metrics.0 to be one of`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareVirtualDNSAnalyticsConfig(rnd, query string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_virtual_dns" "test" {
+  name       = "tf-acctest-%[1]s"
+  origin_ips = ["192.0.2.1"]
+}
+
+data "cloudflare_virtual_dns_analytics" "test" {
+  virtual_dns_id = "${cloudflare_virtual_dns.test.id}"
+%[2]s
+}`, rnd, query)
+}
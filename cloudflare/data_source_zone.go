@@ -0,0 +1,156 @@
+package cloudflare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCloudflareZone() *schema.Resource {
+	s := dataSourceCloudflareZoneAttributes()
+	s["zone"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"zone_id"},
+	}
+	s["zone_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"zone"},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceCloudflareZoneRead,
+		Schema: s,
+	}
+}
+
+// dataSourceCloudflareZoneAttributes is the schema of the attributes the zone
+// data sources return for a zone.
+func dataSourceCloudflareZoneAttributes() map[string]*schema.Schema {
+	stringList := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return map[string]*schema.Schema{
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"paused": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"plan": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name_servers":          stringList,
+		"vanity_name_servers":   stringList,
+		"original_name_servers": stringList,
+		"original_registrar": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"account_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"account_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"owner_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"owner_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"owner_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"meta": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+		"created_on": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"modified_on": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if zoneID == "" {
+		zoneName, ok := d.GetOk("zone")
+		if !ok {
+			return fmt.Errorf("either 'zone' or 'zone_id' must be set")
+		}
+
+		var err error
+		zoneID, err = client.ZoneIDByName(zoneName.(string))
+		if err != nil {
+			return fmt.Errorf("Error finding zone %q: %s", zoneName.(string), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Reading zone %q", zoneID)
+
+	zone, err := client.ZoneDetails(zoneID)
+	if err != nil {
+		return fmt.Errorf("Error finding zone %q: %s", zoneID, err)
+	}
+
+	for k, v := range flattenDataSourceCloudflareZone(zone) {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("Error setting %s: %s", k, err)
+		}
+	}
+	d.Set("zone", zone.Name)
+	d.Set("zone_id", zone.ID)
+
+	d.SetId(zone.ID)
+	return nil
+}
+
+func flattenDataSourceCloudflareZone(zone cloudflare.Zone) map[string]interface{} {
+	return map[string]interface{}{
+		"status":                zone.Status,
+		"paused":                zone.Paused,
+		"plan":                  planIDForName(zone.Plan.Name),
+		"type":                  zone.Type,
+		"name_servers":          flattenStringList(zone.NameServers),
+		"vanity_name_servers":   flattenStringList(zone.VanityNS),
+		"original_name_servers": flattenStringList(zone.OriginalNS),
+		"original_registrar":    zone.OriginalRegistrar,
+		"account_id":            zone.Account.ID,
+		"account_name":          zone.Account.Name,
+		"owner_id":              zone.Owner.ID,
+		"owner_type":            zone.Owner.OwnerType,
+		"owner_name":            zone.Owner.Name,
+		"meta":                  flattenMeta(nil, zone.Meta),
+		"created_on":            zone.CreatedOn.Format(time.RFC3339Nano),
+		"modified_on":           zone.ModifiedOn.Format(time.RFC3339Nano),
+	}
+}
@@ -0,0 +1,58 @@
+package cloudflare
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCloudflareZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudflareZoneFileRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient)
+	zoneName := d.Get("zone").(string)
+
+	log.Printf("[DEBUG] Reading zone file of zone %q", zoneName)
+
+	zoneID, err := client.ZoneIDByName(zoneName)
+	if err != nil {
+		return fmt.Errorf("Error finding zone %q: %s", zoneName, err)
+	}
+
+	records, err := client.DNSRecords(zoneID, cloudflare.DNSRecord{})
+	if err != nil {
+		return fmt.Errorf("Error listing DNS records of zone %q: %s", zoneName, err)
+	}
+
+	d.SetId(zoneID)
+	d.Set("zone_id", zoneID)
+	d.Set("content", renderZoneFile(records, zoneName))
+	d.Set("record_count", len(records))
+
+	return nil
+}
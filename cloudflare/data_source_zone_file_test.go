@@ -0,0 +1,56 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCloudflareZoneFileDataSource(t *testing.T) {
+	name := "data.cloudflare_zone_file.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneFileDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "record_count", "2"),
+					resource.TestCheckResourceAttr(name, "content", fmt.Sprintf(`$ORIGIN %s.
+@	3600	IN	MX	10 mx.example.net.
+www	1	IN	A	192.0.2.1 ; cf_tags=cf-proxied:true
+`, testAccCloudflareZoneFileZone)),
+					resource.TestCheckResourceAttrPair(name, "zone_id", "cloudflare_zone.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneFileDataSourceConfig() string {
+	return testAccCloudflareZoneFileConfigZoneOnly() + `
+resource "cloudflare_record" "www" {
+  domain  = "${cloudflare_zone.test.zone}"
+  name    = "www"
+  value   = "192.0.2.1"
+  type    = "A"
+  proxied = true
+}
+
+resource "cloudflare_record" "mx" {
+  domain   = "${cloudflare_zone.test.zone}"
+  name     = "@"
+  value    = "mx.example.net"
+  type     = "MX"
+  priority = 10
+  ttl      = 3600
+
+  depends_on = ["cloudflare_record.www"]
+}
+
+data "cloudflare_zone_file" "test" {
+  zone = "${cloudflare_record.mx.domain}"
+}`
+}
@@ -0,0 +1,61 @@
+package cloudflare
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCloudflareZoneDataSource(t *testing.T) {
+	t.Parallel()
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	byName := "data.cloudflare_zone.by_name"
+	byID := "data.cloudflare_zone.by_id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneDataSourceConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(byName, "zone", domain),
+					resource.TestCheckResourceAttrSet(byName, "zone_id"),
+					resource.TestCheckResourceAttrPair(byName, "id", byName, "zone_id"),
+					resource.TestCheckResourceAttr(byName, "status", "active"),
+					resource.TestCheckResourceAttr(byName, "paused", "false"),
+					resource.TestCheckResourceAttrSet(byName, "plan"),
+					resource.TestCheckResourceAttr(byName, "type", "full"),
+					resource.TestMatchResourceAttr(byName, "name_servers.0", regexp.MustCompile(`\.ns\.cloudflare\.com$`)),
+					resource.TestCheckResourceAttrSet(byName, "original_name_servers.#"),
+					resource.TestCheckResourceAttr(byName, "vanity_name_servers.#", "0"),
+					resource.TestCheckResourceAttrSet(byName, "account_id"),
+					resource.TestCheckResourceAttrSet(byName, "owner_id"),
+					resource.TestCheckResourceAttrSet(byName, "meta.wildcard_proxiable"),
+					resource.TestCheckResourceAttrSet(byName, "meta.phishing_detected"),
+					resource.TestCheckResourceAttrPair(byID, "id", byName, "id"),
+					resource.TestCheckResourceAttr(byID, "zone", domain),
+					resource.TestCheckResourceAttrPair(byID, "name_servers.0", byName, "name_servers.0"),
+				),
+			},
+			{
+				Config:      `data "cloudflare_zone" "test" {}`,
+				ExpectError: regexp.MustCompile(`either 'zone' or 'zone_id' must be set`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneDataSourceConfig(domain string) string {
+	return fmt.Sprintf(`
+data "cloudflare_zone" "by_name" {
+  zone = "%[1]s"
+}
+
+data "cloudflare_zone" "by_id" {
+  zone_id = "${data.cloudflare_zone.by_name.id}"
+}`, domain)
+}
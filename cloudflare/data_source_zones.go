@@ -1,16 +1,34 @@
 package cloudflare
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 	"regexp"
-	"time"
+	"strconv"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// zonesPageSize is the number of zones requested per page of the zones data
+// source, the maximum the API allows.
+const zonesPageSize = 50
+
 func dataSourceCloudflareZones() *schema.Resource {
+	zone := dataSourceCloudflareZoneAttributes()
+	zone["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	zone["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
 	return &schema.Resource{
 		Read: dataSourceCloudflareZonesRead,
 
@@ -23,13 +41,28 @@ func dataSourceCloudflareZones() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.ValidateRegexp,
+						},
+						"lookup_name": {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"lookup_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "exact",
+							ValidateFunc: validation.StringInSlice([]string{"exact", "contains", "starts_with", "ends_with"}, false),
+						},
 						"status": {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"paused": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -39,10 +72,11 @@ func dataSourceCloudflareZones() *schema.Resource {
 				},
 			},
 			"zones": {
-				Type:     schema.TypeSet,
+				Type:     schema.TypeList,
 				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Resource{
+					Schema: zone,
+				},
 			},
 		},
 	}
@@ -50,22 +84,25 @@ func dataSourceCloudflareZones() *schema.Resource {
 
 func dataSourceCloudflareZonesRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Reading Zones")
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	filter, err := expandFilter(d.Get("filter"))
 	if err != nil {
 		return err
 	}
+	if filter.accountID == "" {
+		filter.accountID = client.OrganizationID
+	}
 
-	zones, err := client.ListZones()
+	zones, err := listZones(client, filter.query())
 	if err != nil {
 		return fmt.Errorf("error listing Zone: %s", err)
 	}
 
-	var zoneNames []string
+	results := make([]map[string]interface{}, 0, len(zones))
 	for _, v := range zones {
 
 		if filter.name != nil {
-			if !filter.name.Match([]byte(v.Name)) {
+			if !filter.name.MatchString(v.Name) {
 				continue
 			}
 		}
@@ -74,29 +111,57 @@ func dataSourceCloudflareZonesRead(d *schema.ResourceData, meta interface{}) err
 			continue
 		}
 
-		if filter.status != "" && filter.status != v.Status {
-			continue
-		}
-
-		zoneNames = append(zoneNames, v.Name)
+		zone := flattenDataSourceCloudflareZone(v)
+		zone["id"] = v.ID
+		zone["name"] = v.Name
+		results = append(results, zone)
 	}
 
-	err = d.Set("zones", zoneNames)
+	err = d.Set("zones", results)
 	if err != nil {
 		return fmt.Errorf("Error setting zones: %s", err)
 	}
 
-	d.SetId(time.Now().UTC().String())
+	d.SetId(filter.id())
 	return nil
 }
 
+// listZones lists the zones matching query, one page at a time. The SDK's
+// ListZonesContext can only filter on the name of a zone, so the zones are
+// listed with raw requests instead.
+func listZones(client *providerClient, query url.Values) ([]cloudflare.Zone, error) {
+	var zones []cloudflare.Zone
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		query.Set("per_page", strconv.Itoa(zonesPageSize))
+
+		res, err := client.Raw("GET", "/zones?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []cloudflare.Zone
+		if err := json.Unmarshal(res, &result); err != nil {
+			return nil, err
+		}
+		zones = append(zones, result...)
+
+		if len(result) < zonesPageSize {
+			return zones, nil
+		}
+	}
+}
+
 func expandFilter(d interface{}) (*searchFilter, error) {
 	cfg := d.([]interface{})
 	filter := &searchFilter{}
+	if len(cfg) == 0 || cfg[0] == nil {
+		return filter, nil
+	}
 
 	m := cfg[0].(map[string]interface{})
 	name, ok := m["name"]
-	if ok {
+	if ok && name.(string) != "" {
 		match, err := regexp.Compile(name.(string))
 		if err != nil {
 			return nil, err
@@ -105,6 +170,14 @@ func expandFilter(d interface{}) (*searchFilter, error) {
 		filter.name = match
 	}
 
+	if lookupName, ok := m["lookup_name"]; ok {
+		filter.lookupName = lookupName.(string)
+	}
+
+	if lookupType, ok := m["lookup_type"]; ok {
+		filter.lookupType = lookupType.(string)
+	}
+
 	paused, ok := m["paused"]
 	if ok {
 		filter.paused = paused.(bool)
@@ -115,11 +188,49 @@ func expandFilter(d interface{}) (*searchFilter, error) {
 		filter.status = status.(string)
 	}
 
+	accountID, ok := m["account_id"]
+	if ok {
+		filter.accountID = accountID.(string)
+	}
+
 	return filter, nil
 }
 
 type searchFilter struct {
-	name   *regexp.Regexp
-	status string
-	paused bool
+	name       *regexp.Regexp
+	lookupName string
+	lookupType string
+	status     string
+	accountID  string
+	paused     bool
+}
+
+// query returns the parameters of the zone listing that apply the filter on
+// the API's side, everything but name and paused.
+func (f *searchFilter) query() url.Values {
+	query := url.Values{}
+	if f.lookupName != "" {
+		if f.lookupType != "" && f.lookupType != "exact" {
+			query.Set("name", f.lookupType+":"+f.lookupName)
+		} else {
+			query.Set("name", f.lookupName)
+		}
+	}
+	if f.status != "" {
+		query.Set("status", f.status)
+	}
+	if f.accountID != "" {
+		query.Set("account.id", f.accountID)
+	}
+	return query
+}
+
+// id returns an ID that is the same for every read with the same filter.
+func (f *searchFilter) id() string {
+	name := ""
+	if f.name != nil {
+		name = f.name.String()
+	}
+	key := fmt.Sprintf("%s|%s|%t", f.query().Encode(), name, f.paused)
+	return strconv.Itoa(hashcode.String(key))
 }
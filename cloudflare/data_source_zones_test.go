@@ -26,6 +26,43 @@ func TestAccCloudflareZonesMatchName(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareZonesLookupName(t *testing.T) {
+	name := "data.cloudflare_zones.examples_domains"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZonesConfigLookupName("baa.com", "exact"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zones.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "zones.0.id", "cloudflare_zone.baa_com", "id"),
+					resource.TestCheckResourceAttr(name, "zones.0.name", "baa.com"),
+					resource.TestCheckResourceAttrPair(name, "zones.0.status", "cloudflare_zone.baa_com", "status"),
+					resource.TestCheckResourceAttr(name, "zones.0.paused", "false"),
+					resource.TestCheckResourceAttr(name, "zones.0.plan", "free"),
+					resource.TestCheckResourceAttrSet(name, "zones.0.name_servers.#"),
+					testAccCheckCloudflareZonesDataSourceStableID(name),
+				),
+			},
+			{
+				Config: testAccCloudflareZonesConfigLookupName("baa", "contains"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zones.#", "1"),
+					resource.TestCheckResourceAttr(name, "zones.0.name", "baa.com"),
+				),
+			},
+			{
+				Config: testAccCloudflareZonesConfigLookupName(".net", "ends_with"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zones.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareZonesMatchPaused(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -75,6 +112,28 @@ func testAccCheckCloudflareZonesDataSourceID(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckCloudflareZonesDataSourceStableID checks that the ID of the data
+// source only depends on its filter, so that refreshing it doesn't change it.
+func testAccCheckCloudflareZonesDataSourceStableID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find zones data source: %s", n)
+		}
+
+		filter := &searchFilter{
+			lookupName: rs.Primary.Attributes["filter.0.lookup_name"],
+			lookupType: rs.Primary.Attributes["filter.0.lookup_type"],
+			accountID:  testAccProvider.Meta().(*providerClient).OrganizationID,
+		}
+
+		if rs.Primary.ID != filter.id() {
+			return fmt.Errorf("expected ID %q, got %q", filter.id(), rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckCloudflareZonesReturned(n string, a string, check func(int) bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		all := s.RootModule().Resources
@@ -108,6 +167,20 @@ data "cloudflare_zones" "examples_domains" {
 `, testZones)
 }
 
+func testAccCloudflareZonesConfigLookupName(name, lookupType string) string {
+	return fmt.Sprintf(`
+data "cloudflare_zones" "examples_domains" {
+  filter {
+    lookup_name = "%[1]s"
+    lookup_type = "%[2]s"
+    paused      = "${cloudflare_zone.baa_com.paused}" // false
+  }
+}
+
+%[3]s
+`, name, lookupType, testZones)
+}
+
 func testAccCloudflareZonesConfigMatchPaused() string {
 	return fmt.Sprintf(`
 data "cloudflare_zones" "examples_domains" {
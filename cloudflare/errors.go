@@ -0,0 +1,141 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// apiErrorPattern matches the error the SDK returns for an unsuccessful
+// response: the HTTP status, optionally followed by the quoted response body.
+var apiErrorPattern = regexp.MustCompile(`HTTP status (\d{3}): (?:content ("(?:[^"\\]|\\.)*"))?`)
+
+// notFoundErrorCodes are API error codes that only ever mean the requested
+// object doesn't exist.
+var notFoundErrorCodes = map[int]bool{
+	7003:  true, // Could not route to ..., perhaps your object identifier is invalid?
+	10007: true, // workers.api.error.script_not_found
+	81044: true, // Record does not exist.
+}
+
+// notFoundErrorMessages are messages some endpoints return, with a status
+// other than 404, for objects that don't exist.
+var notFoundErrorMessages = []string{
+	"invalid page rule identifier",
+	"invalid dns record identifier",
+	"member not found",
+}
+
+// conflictErrorCodes are API error codes returned when an object can't be
+// created because it clashes with an existing one.
+var conflictErrorCodes = map[int]bool{
+	1061:  true, // the zone already exists
+	81053: true, // an A, AAAA or CNAME record already exists with that host
+	81057: true, // the record already exists
+}
+
+// rateLimitedErrorCodes are API error codes returned when requests are being
+// throttled.
+var rateLimitedErrorCodes = map[int]bool{
+	971: true, // Please wait and consider throttling your request speed
+}
+
+// apiError is an unsuccessful Cloudflare API response recovered from the
+// error returned by the SDK.
+type apiError struct {
+	StatusCode int
+	Errors     []apiErrorDetail
+}
+
+type apiErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *apiError) hasCode(codes map[int]bool) bool {
+	for _, detail := range e.Errors {
+		if codes[detail.Code] {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *apiError) hasMessage(messages []string) bool {
+	for _, detail := range e.Errors {
+		for _, m := range messages {
+			if strings.Contains(strings.ToLower(detail.Message), m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseAPIError extracts the HTTP status and the error envelope from an SDK
+// error. It returns nil if err isn't the result of an API response, e.g. a
+// network error.
+func parseAPIError(err error) *apiError {
+	if err == nil {
+		return nil
+	}
+
+	match := apiErrorPattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+
+	status, _ := strconv.Atoi(match[1])
+	e := &apiError{StatusCode: status}
+
+	if match[2] != "" {
+		body, unquoteErr := strconv.Unquote(match[2])
+		if unquoteErr == nil {
+			var envelope struct {
+				Errors []apiErrorDetail `json:"errors"`
+			}
+			if json.Unmarshal([]byte(body), &envelope) == nil {
+				e.Errors = envelope.Errors
+			}
+		}
+	}
+
+	return e
+}
+
+// isNotFound reports whether err means the requested object doesn't exist.
+// Server errors are never treated as not found so that a transient failure
+// doesn't remove a resource from state.
+func isNotFound(err error) bool {
+	e := parseAPIError(err)
+	if e == nil || e.StatusCode >= http.StatusInternalServerError {
+		return false
+	}
+
+	return e.StatusCode == http.StatusNotFound ||
+		e.hasCode(notFoundErrorCodes) ||
+		e.hasMessage(notFoundErrorMessages)
+}
+
+// isConflict reports whether err means the object clashes with an existing
+// one.
+func isConflict(err error) bool {
+	e := parseAPIError(err)
+	if e == nil || e.StatusCode >= http.StatusInternalServerError {
+		return false
+	}
+
+	return e.StatusCode == http.StatusConflict || e.hasCode(conflictErrorCodes)
+}
+
+// isRateLimited reports whether err means the request was throttled.
+func isRateLimited(err error) bool {
+	e := parseAPIError(err)
+	if e == nil {
+		return false
+	}
+
+	return e.StatusCode == http.StatusTooManyRequests || e.hasCode(rateLimitedErrorCodes)
+}
@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/terraform-providers/terraform-provider-cloudflare/internal/fakeapi"
+)
+
+// sdkError builds an error the way the SDK reports an unsuccessful response.
+func sdkError(status int, body string) error {
+	return fmt.Errorf("error from makeRequest: HTTP status %d: content %q", status, body)
+}
+
+func TestErrorClassification(t *testing.T) {
+	cases := map[string]struct {
+		err         error
+		notFound    bool
+		conflict    bool
+		rateLimited bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"network error": {
+			err: errors.New("dial tcp 192.0.2.1:443: connect: connection refused"),
+		},
+		"404": {
+			err:      sdkError(404, `{"success":false,"errors":[{"code":81044,"message":"Record does not exist."}]}`),
+			notFound: true,
+		},
+		"404 without body": {
+			err:      errors.New("HTTP status 404: content \"\""),
+			notFound: true,
+		},
+		"invalid page rule identifier": {
+			err:      sdkError(400, `{"success":false,"errors":[{"code":1002,"message":"Invalid Page Rule identifier"}]}`),
+			notFound: true,
+		},
+		"invalid dns record identifier": {
+			err:      sdkError(400, `{"success":false,"errors":[{"code":1032,"message":"Invalid dns record identifier"}]}`),
+			notFound: true,
+		},
+		"wrapped by a resource": {
+			err:      fmt.Errorf("Error finding Zone %q: %s", "abc", sdkError(404, `{"success":false,"errors":[]}`)),
+			notFound: true,
+		},
+		"validation error": {
+			err: sdkError(400, `{"success":false,"errors":[{"code":9005,"message":"Content for A record is invalid."}]}`),
+		},
+		"service failure mentioning 404": {
+			err: errors.New("HTTP status 503: service failure while fetching /zones/404"),
+		},
+		"server error with not found code": {
+			err: sdkError(500, `{"success":false,"errors":[{"code":7003,"message":"Could not route to /zones/abc"}]}`),
+		},
+		"duplicate record": {
+			err:      sdkError(400, `{"success":false,"errors":[{"code":81057,"message":"The record already exists."}]}`),
+			conflict: true,
+		},
+		"409": {
+			err:      sdkError(409, `{"success":false,"errors":[]}`),
+			conflict: true,
+		},
+		"429": {
+			err:         sdkError(429, `{"success":false,"errors":[{"code":10000,"message":"Rate limited"}]}`),
+			rateLimited: true,
+		},
+	}
+
+	for name, c := range cases {
+		if got := isNotFound(c.err); got != c.notFound {
+			t.Errorf("%s: expected isNotFound to be %t, got %t", name, c.notFound, got)
+		}
+		if got := isConflict(c.err); got != c.conflict {
+			t.Errorf("%s: expected isConflict to be %t, got %t", name, c.conflict, got)
+		}
+		if got := isRateLimited(c.err); got != c.rateLimited {
+			t.Errorf("%s: expected isRateLimited to be %t, got %t", name, c.rateLimited, got)
+		}
+	}
+}
+
+func TestParseAPIError(t *testing.T) {
+	e := parseAPIError(sdkError(400, `{"success":false,"errors":[{"code":1004,"message":"DNS Validation Error \"quoted\""}]}`))
+	if e == nil {
+		t.Fatal("expected an API error")
+	}
+	if e.StatusCode != 400 || len(e.Errors) != 1 || e.Errors[0].Code != 1004 || e.Errors[0].Message != `DNS Validation Error "quoted"` {
+		t.Fatalf("unexpected API error %#v", e)
+	}
+}
+
+func TestErrorClassification_SDKErrors(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer server.Close()
+
+	client, err := cloudflare.New("fake-api-key", "user@example.com", cloudflare.UsingRetryPolicy(0, 0, 0), usingBaseURL(server.BaseURL()))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	zoneID, err := client.ZoneIDByName("example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err = client.DNSRecord(zoneID, "023e105f4ecef8ad9ca31a8372d0c353")
+	if !isNotFound(err) {
+		t.Errorf("expected reading a missing record to be not found, got %v", err)
+	}
+
+	record := cloudflare.DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1"}
+	if _, err := client.CreateDNSRecord(zoneID, record); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	_, err = client.CreateDNSRecord(zoneID, record)
+	if !isConflict(err) || isNotFound(err) {
+		t.Errorf("expected creating a duplicate record to be a conflict, got %v", err)
+	}
+}
@@ -0,0 +1,476 @@
+package cloudflare
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// exportPageSize is the number of objects requested per page by the
+// exporters of paginated endpoints.
+const exportPageSize = 50
+
+// exportedResource is an existing object found by ExportZone, along with the
+// ID its resource's importer expects.
+type exportedResource struct {
+	Type     string
+	Name     string
+	ImportID string
+}
+
+// zoneExporter lists the objects of one resource type in a zone.
+type zoneExporter func(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error)
+
+// zoneExporters are run in order, so that resources are written after the
+// resources they reference.
+var zoneExporters = []zoneExporter{
+	exportZoneSettings,
+	exportRecords,
+	exportPageRules,
+	exportFilters,
+	exportFirewallRules,
+	exportRateLimits,
+	exportAccessRules,
+	exportWorkerRoutes,
+	exportLoadBalancers,
+}
+
+// exportReferences are attributes holding the ID of another exported
+// resource, written as a reference to it instead of the literal ID.
+var exportReferences = map[string]string{
+	"cloudflare_firewall_rule.filter_id": "cloudflare_filter",
+}
+
+// ExportZone writes the Terraform configuration of the existing records,
+// rules, routes, load balancers and settings of zoneName to config, and the
+// terraform import commands that bring them under management to script. p
+// must be a configured provider returned by Provider.
+//
+// Each object is imported and read with its resource's own importer and Read
+// function, so the configuration matches what Terraform would read and
+// planning it after running the imports shows no changes.
+func ExportZone(p terraform.ResourceProvider, zoneName string, config, script io.Writer) error {
+	provider := p.(*schema.Provider)
+	client := provider.Meta().(*providerClient)
+
+	zoneID, err := client.ZoneIDByName(zoneName)
+	if err != nil {
+		return fmt.Errorf("error finding zone %q: %s", zoneName, err)
+	}
+	zone, err := client.ZoneDetails(zoneID)
+	if err != nil {
+		return fmt.Errorf("error reading zone %q: %s", zoneName, err)
+	}
+
+	names := make(map[string]bool)
+	references := make(map[string]string)
+	for _, exporter := range zoneExporters {
+		resources, err := exporter(client, zone)
+		if err != nil {
+			return err
+		}
+
+		for _, res := range resources {
+			res.Name = uniqueExportName(names, res.Type, res.Name)
+
+			state, err := importResourceState(provider, res)
+			if err != nil {
+				return fmt.Errorf("error importing %s %q: %s", res.Type, res.ImportID, err)
+			}
+			if state == nil {
+				log.Printf("[WARN] %s %q disappeared while exporting zone %q", res.Type, res.ImportID, zoneName)
+				continue
+			}
+
+			r := provider.ResourcesMap[res.Type]
+			address := res.Type + "." + res.Name
+			if _, err := config.Write(renderExportedResource(r, res, r.Data(state), references)); err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(script, "terraform import %s %s\n", address, shellQuote(res.ImportID)); err != nil {
+				return err
+			}
+
+			references[res.Type+"/"+state.ID] = address
+		}
+	}
+
+	return nil
+}
+
+// importResourceState imports and reads an object the way terraform import
+// does, returning nil if it no longer exists.
+func importResourceState(provider *schema.Provider, res exportedResource) (*terraform.InstanceState, error) {
+	r := provider.ResourcesMap[res.Type]
+
+	d := r.Data(nil)
+	d.SetId(res.ImportID)
+
+	data := []*schema.ResourceData{d}
+	if r.Importer != nil && r.Importer.State != nil {
+		var err error
+		data, err = r.Importer.State(d, provider.Meta())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(data) != 1 {
+		return nil, fmt.Errorf("expected the import to return one resource, got %d", len(data))
+	}
+
+	state := data[0].State()
+	if state == nil {
+		return nil, nil
+	}
+	return r.Refresh(state, provider.Meta())
+}
+
+func exportZoneSettings(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	return []exportedResource{{
+		Type:     "cloudflare_zone_settings_override",
+		Name:     zone.Name,
+		ImportID: zone.Name,
+	}}, nil
+}
+
+func exportRecords(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	records, err := client.DNSRecords(zone.ID, cloudflare.DNSRecord{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing DNS records of zone %q: %s", zone.Name, err)
+	}
+
+	var resources []exportedResource
+	for _, record := range records {
+		name := "apex"
+		if record.Name != zone.Name {
+			name = strings.TrimSuffix(record.Name, "."+zone.Name)
+		}
+		resources = append(resources, exportedResource{
+			Type:     "cloudflare_record",
+			Name:     record.Type + "_" + name,
+			ImportID: zone.Name + "/" + record.ID,
+		})
+	}
+	return resources, nil
+}
+
+func exportPageRules(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	rules, err := client.ListPageRules(zone.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing page rules of zone %q: %s", zone.Name, err)
+	}
+
+	var resources []exportedResource
+	for _, rule := range rules {
+		resources = append(resources, exportedResource{
+			Type:     "cloudflare_page_rule",
+			Name:     fmt.Sprintf("priority_%d", rule.Priority),
+			ImportID: zone.Name + "/" + rule.ID,
+		})
+	}
+	return resources, nil
+}
+
+func exportFilters(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	var resources []exportedResource
+	for page := 1; ; page++ {
+		filters, err := client.Filters(zone.ID, cloudflare.PaginationOptions{Page: page, PerPage: exportPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("error listing filters of zone %q: %s", zone.Name, err)
+		}
+
+		for _, filter := range filters {
+			resources = append(resources, exportedResource{
+				Type:     "cloudflare_filter",
+				Name:     exportNameOr(filter.Description, "filter"),
+				ImportID: zone.ID + "/" + filter.ID,
+			})
+		}
+
+		if len(filters) < exportPageSize {
+			return resources, nil
+		}
+	}
+}
+
+func exportFirewallRules(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	var resources []exportedResource
+	for page := 1; ; page++ {
+		rules, err := client.FirewallRules(zone.ID, cloudflare.PaginationOptions{Page: page, PerPage: exportPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("error listing firewall rules of zone %q: %s", zone.Name, err)
+		}
+
+		for _, rule := range rules {
+			resources = append(resources, exportedResource{
+				Type:     "cloudflare_firewall_rule",
+				Name:     exportNameOr(rule.Description, "firewall_rule"),
+				ImportID: zone.ID + "/" + rule.ID,
+			})
+		}
+
+		if len(rules) < exportPageSize {
+			return resources, nil
+		}
+	}
+}
+
+func exportRateLimits(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	limits, err := client.ListAllRateLimits(zone.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing rate limits of zone %q: %s", zone.Name, err)
+	}
+
+	var resources []exportedResource
+	for _, limit := range limits {
+		resources = append(resources, exportedResource{
+			Type:     "cloudflare_rate_limit",
+			Name:     exportNameOr(limit.Description, "rate_limit"),
+			ImportID: zone.Name + "/" + limit.ID,
+		})
+	}
+	return resources, nil
+}
+
+func exportAccessRules(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	var resources []exportedResource
+	for page := 1; ; page++ {
+		res, err := client.ListZoneAccessRules(zone.ID, cloudflare.AccessRule{}, page)
+		if err != nil {
+			return nil, fmt.Errorf("error listing access rules of zone %q: %s", zone.Name, err)
+		}
+
+		for _, rule := range res.Result {
+			// the rules of the zone's account and user apply to the zone too,
+			// but belong to the account or the user
+			if rule.Scope.Type != "" && rule.Scope.Type != "zone" {
+				continue
+			}
+			resources = append(resources, exportedResource{
+				Type:     "cloudflare_access_rule",
+				Name:     rule.Mode + "_" + rule.Configuration.Value,
+				ImportID: "zone/" + zone.ID + "/" + rule.ID,
+			})
+		}
+
+		if page >= res.ResultInfo.TotalPages {
+			return resources, nil
+		}
+	}
+}
+
+func exportWorkerRoutes(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	res, err := client.ListWorkerRoutes(zone.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing worker routes of zone %q: %s", zone.Name, err)
+	}
+
+	var resources []exportedResource
+	for _, route := range res.Routes {
+		resources = append(resources, exportedResource{
+			Type:     "cloudflare_worker_route",
+			Name:     route.Pattern,
+			ImportID: zone.Name + "/" + route.ID,
+		})
+	}
+	return resources, nil
+}
+
+func exportLoadBalancers(client *providerClient, zone cloudflare.Zone) ([]exportedResource, error) {
+	loadBalancers, err := client.ListLoadBalancers(zone.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing load balancers of zone %q: %s", zone.Name, err)
+	}
+
+	var resources []exportedResource
+	for _, lb := range loadBalancers {
+		resources = append(resources, exportedResource{
+			Type:     "cloudflare_load_balancer",
+			Name:     lb.Name,
+			ImportID: zone.Name + "/" + lb.ID,
+		})
+	}
+	return resources, nil
+}
+
+var exportNameInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportNameOr returns name, or fallback if name is empty.
+func exportNameOr(name, fallback string) string {
+	if name == "" {
+		return fallback
+	}
+	return name
+}
+
+// uniqueExportName turns name into a valid resource name that isn't already
+// used by another resource of the same type.
+func uniqueExportName(names map[string]bool, resourceType, name string) string {
+	name = strings.Trim(exportNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	unique := name
+	for i := 2; names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[resourceType+"."+unique] = true
+
+	return unique
+}
+
+// renderExportedResource writes the configuration of a resource from its
+// state. Only arguments are written, leaving out attributes that are only
+// computed, optional arguments that are empty or set to their default and
+// arguments conflicting with one already written.
+func renderExportedResource(r *schema.Resource, res exportedResource, d *schema.ResourceData, references map[string]string) []byte {
+	values := make(map[string]interface{}, len(r.Schema))
+	for k := range r.Schema {
+		values[k] = d.Get(k)
+		if referencedType, ok := exportReferences[res.Type+"."+k]; ok {
+			if address, ok := references[referencedType+"/"+values[k].(string)]; ok {
+				values[k] = exportReference("${" + address + ".id}")
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "resource %q %q {\n", res.Type, res.Name)
+	renderExportedBlock(&buf, r.Schema, values, 1)
+	buf.WriteString("}\n\n")
+
+	return buf.Bytes()
+}
+
+// exportReference is an interpolation, which is written unescaped.
+type exportReference string
+
+func renderExportedBlock(buf *bytes.Buffer, schemaMap map[string]*schema.Schema, values map[string]interface{}, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	keys := make([]string, 0, len(schemaMap))
+	for k := range schemaMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	written := make(map[string]bool)
+	for _, k := range keys {
+		s := schemaMap[k]
+		v := values[k]
+
+		if s.Computed && !s.Optional || s.Deprecated != "" || s.Removed != "" {
+			continue
+		}
+		if !s.Required && (s.Default == nil && isZeroExportValue(v) || s.Default != nil && reflect.DeepEqual(v, s.Default)) {
+			continue
+		}
+		conflicting := false
+		for _, c := range s.ConflictsWith {
+			conflicting = conflicting || written[c]
+		}
+		if conflicting {
+			continue
+		}
+		written[k] = true
+
+		if elem, ok := s.Elem.(*schema.Resource); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			for _, item := range exportList(v) {
+				fmt.Fprintf(buf, "%s%s {\n", indent, k)
+				if m, ok := item.(map[string]interface{}); ok {
+					renderExportedBlock(buf, elem.Schema, m, depth+1)
+				}
+				fmt.Fprintf(buf, "%s}\n", indent)
+			}
+			continue
+		}
+
+		fmt.Fprintf(buf, "%s%s = %s\n", indent, k, renderExportValue(v, depth))
+	}
+}
+
+func renderExportValue(v interface{}, depth int) string {
+	switch v := v.(type) {
+	case exportReference:
+		return strconv.Quote(string(v))
+	case string:
+		return strings.Replace(strconv.Quote(v), "${", "$${", -1)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var buf bytes.Buffer
+		buf.WriteString("{\n")
+		for _, k := range keys {
+			fmt.Fprintf(&buf, "%s%s = %s\n", strings.Repeat("  ", depth+1), strconv.Quote(k), renderExportValue(v[k], depth+1))
+		}
+		buf.WriteString(strings.Repeat("  ", depth) + "}")
+		return buf.String()
+	case []interface{}, *schema.Set:
+		items := exportList(v)
+		rendered := make([]string, len(items))
+		for i, item := range items {
+			rendered[i] = renderExportValue(item, depth)
+		}
+		return "[" + strings.Join(rendered, ", ") + "]"
+	default:
+		return strconv.Quote(fmt.Sprintf("%v", v))
+	}
+}
+
+func exportList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+func isZeroExportValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	}
+	return false
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'"'"'`, -1) + "'"
+}
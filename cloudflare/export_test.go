@@ -0,0 +1,204 @@
+package cloudflare
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-cloudflare/internal/fakeapi"
+)
+
+func TestExportZone(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer server.Close()
+
+	p, err := testProviderConfigure(t, map[string]interface{}{
+		"email":        "user@example.com",
+		"token":        "fake-api-key",
+		"api_base_url": server.BaseURL(),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client := p.Meta().(*providerClient)
+
+	zoneID, err := client.ZoneIDByName("example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	testExportZoneObjects(t, client, zoneID)
+
+	var configBuf, scriptBuf bytes.Buffer
+	if err := ExportZone(p, "example.com", &configBuf, &scriptBuf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	imports := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(scriptBuf.String()), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 4 || fields[0] != "terraform" || fields[1] != "import" {
+			t.Fatalf("unexpected import command %q", line)
+		}
+		imports[fields[2]] = strings.Trim(fields[3], "'")
+	}
+
+	for _, address := range []string{
+		"cloudflare_zone_settings_override.example_com",
+		"cloudflare_record.a_www",
+		"cloudflare_record.a_www_2",
+		"cloudflare_record.mx_apex",
+		"cloudflare_record.srv__sip__udp",
+		"cloudflare_page_rule.priority_1",
+		"cloudflare_filter.block_bad_bots",
+		"cloudflare_firewall_rule.block_bad_bots",
+		"cloudflare_access_rule.block_192_0_2_1",
+	} {
+		if _, ok := imports[address]; !ok {
+			t.Errorf("expected %s to be exported, got %v", address, imports)
+		}
+	}
+	if imports["cloudflare_record.mx_apex"] == "" || !strings.HasPrefix(imports["cloudflare_record.mx_apex"], "example.com/") {
+		t.Errorf("expected records to be imported by zoneName/recordId, got %q", imports["cloudflare_record.mx_apex"])
+	}
+	if !strings.Contains(configBuf.String(), `filter_id = "${cloudflare_filter.block_bad_bots.id}"`) {
+		t.Errorf("expected the firewall rule to reference the exported filter:\n%s", configBuf.String())
+	}
+	if !strings.Contains(configBuf.String(), "  srv {\n") {
+		t.Errorf("expected the SRV record to be exported with its srv block:\n%s", configBuf.String())
+	}
+
+	// The configuration must parse and, once everything is imported, plan
+	// without changes.
+	dir, err := ioutil.TempDir("", "cloudflare-export")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "example.com.tf"), configBuf.Bytes(), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	cfg, err := config.LoadDir(dir)
+	if err != nil {
+		t.Fatalf("error loading the exported configuration: %s\n%s", err, configBuf.String())
+	}
+	if len(cfg.Resources) != len(imports) {
+		t.Fatalf("expected %d resources in the configuration, got %d", len(imports), len(cfg.Resources))
+	}
+
+	provider := p
+	for _, res := range cfg.Resources {
+		address := res.Type + "." + res.Name
+		r := provider.ResourcesMap[res.Type]
+
+		rc := terraform.NewResourceConfig(res.RawConfig)
+		if _, errs := r.Validate(rc); len(errs) > 0 {
+			t.Errorf("%s: invalid configuration: %v", address, errs)
+			continue
+		}
+
+		state, err := importResourceState(provider, exportedResource{Type: res.Type, Name: res.Name, ImportID: imports[address]})
+		if err != nil {
+			t.Fatalf("%s: err: %s", address, err)
+		}
+		diff, err := r.Diff(state, rc, provider.Meta())
+		if err != nil {
+			t.Fatalf("%s: err: %s", address, err)
+		}
+		if diff == nil {
+			continue
+		}
+		for k, attr := range diff.Attributes {
+			// references to other resources are unknown until they're resolved
+			if attr.NewComputed || strings.Contains(attr.New, "${") {
+				continue
+			}
+			t.Errorf("%s: expected no changes after import, got %s: %#v", address, k, attr)
+		}
+	}
+}
+
+func testExportZoneObjects(t *testing.T, client *providerClient, zoneID string) {
+	records := []cloudflare.DNSRecord{
+		{Type: "A", Name: "www", Content: "192.0.2.1", TTL: 1},
+		{Type: "A", Name: "www", Content: "192.0.2.2", TTL: 1, Proxied: true},
+		{Type: "MX", Name: "example.com", Content: "mx.example.com", TTL: 3600, Priority: 10},
+		{Type: "SRV", Name: "_sip._udp", TTL: 1, Data: map[string]interface{}{
+			"service":  "_sip",
+			"proto":    "_udp",
+			"name":     "example.com",
+			"priority": 10,
+			"weight":   60,
+			"port":     5060,
+			"target":   "sip.example.com",
+		}},
+	}
+	for _, record := range records {
+		if _, err := client.CreateDNSRecord(zoneID, record); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	target := cloudflare.PageRuleTarget{Target: "url"}
+	target.Constraint.Operator = "matches"
+	target.Constraint.Value = "example.com/app/*"
+	_, err := client.CreatePageRule(zoneID, cloudflare.PageRule{
+		Targets:  []cloudflare.PageRuleTarget{target},
+		Actions:  []cloudflare.PageRuleAction{{ID: "always_use_https"}},
+		Priority: 1,
+		Status:   "active",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	filters, err := client.CreateFilters(zoneID, []cloudflare.Filter{{
+		Expression:  `(http.user_agent contains "BadBot")`,
+		Description: "Block bad bots",
+	}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	_, err = client.CreateFirewallRules(zoneID, []cloudflare.FirewallRule{{
+		Filter:      cloudflare.Filter{ID: filters[0].ID},
+		Action:      "block",
+		Description: "Block bad bots",
+	}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err = client.CreateZoneAccessRule(zoneID, cloudflare.AccessRule{
+		Mode:          "block",
+		Configuration: cloudflare.AccessRuleConfiguration{Target: "ip", Value: "192.0.2.1"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestUniqueExportName(t *testing.T) {
+	names := make(map[string]bool)
+
+	cases := []struct {
+		resourceType, name, expected string
+	}{
+		{"cloudflare_record", "A_www", "a_www"},
+		{"cloudflare_record", "A_www", "a_www_2"},
+		{"cloudflare_page_rule", "a_www", "a_www"},
+		{"cloudflare_record", "CNAME_*.dev", "cname__dev"},
+		{"cloudflare_worker_route", "example.com/api/*", "example_com_api"},
+		{"cloudflare_record", "1.example", "r_1_example"},
+		{"cloudflare_filter", "", "r_"},
+	}
+	for _, c := range cases {
+		if got := uniqueExportName(names, c.resourceType, c.name); got != c.expected {
+			t.Errorf("%s %q: expected %q, got %q", c.resourceType, c.name, c.expected, got)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccCloudflareSpectrumApplication_Import(t *testing.T) {
@@ -30,10 +31,10 @@ func TestAccCloudflareSpectrumApplication_Import(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:        name,
-				ImportStateIdPrefix: fmt.Sprintf("%s/", zone),
-				ImportState:         true,
-				ImportStateVerify:   true,
+				ResourceName:      name,
+				ImportStateIdFunc: testAccCloudflareSpectrumApplicationImportID(name),
+				ImportState:       true,
+				ImportStateVerify: true,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareSpectrumApplicationExists(name, &application),
 					testAccCheckCloudflareSpectrumApplicationIDIsValid(name),
@@ -42,3 +43,14 @@ func TestAccCloudflareSpectrumApplication_Import(t *testing.T) {
 		},
 	})
 }
+
+func testAccCloudflareSpectrumApplicationImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["zone_id"] + "/" + rs.Primary.ID, nil
+	}
+}
@@ -11,6 +11,15 @@ import (
 )
 
 func TestAccCloudflareWorkerRoute_Import(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_ORG_ID if it is set in order
+	// to test non-ENT behavior
+	if os.Getenv("CLOUDFLARE_ORG_ID") != "" {
+		defer func(orgId string) {
+			os.Setenv("CLOUDFLARE_ORG_ID", orgId)
+		}(os.Getenv("CLOUDFLARE_ORG_ID"))
+		os.Setenv("CLOUDFLARE_ORG_ID", "")
+	}
+
 	var route cloudflare.WorkerRoute
 	zone := os.Getenv("CLOUDFLARE_DOMAIN")
 	routeRnd := acctest.RandString(10)
@@ -1,10 +1,13 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/go-cleanhttp"
@@ -13,24 +16,44 @@ import (
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-cloudflare/version"
+	"golang.org/x/time/rate"
 )
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"email": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_EMAIL", nil),
-				Description: "A registered Cloudflare email address.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDFLARE_EMAIL", nil),
+				Description:   "A registered Cloudflare email address.",
+				ConflictsWith: []string{"api_token"},
 			},
 
 			"token": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDFLARE_TOKEN", nil),
+				Description:   "The global API key for API operations.",
+				ConflictsWith: []string{"api_token"},
+			},
+
+			"api_token": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDFLARE_API_TOKEN", nil),
+				Description:   "A scoped API token for API operations. Conflicts with 'email' and 'token'.",
+				ConflictsWith: []string{"email", "token"},
+			},
+
+			"user_service_key": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_TOKEN", nil),
-				Description: "The token key for API operations.",
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_API_USER_SERVICE_KEY", nil),
+				Description: "A special Cloudflare API key good for a restricted set of endpoints, such as the Origin CA certificates API.",
 			},
 
 			"rps": &schema.Schema{
@@ -61,6 +84,13 @@ func Provider() terraform.ResourceProvider {
 				Description: "Maximum backoff period in seconds after failed API calls",
 			},
 
+			"retryable_error_codes": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Cloudflare API error codes of transient failures to retry even though they are returned with a 4xx status",
+			},
+
 			"api_client_logging": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -81,58 +111,111 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_ORG_ID", nil),
 				Description: "Configure API client to always use that organization. If set this will override 'user_owner_from_zone'",
 			},
+
+			"account_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDFLARE_ACCOUNT_ID", nil),
+				Description:   "Configure API client to always use that account. Account-scoped resources can override it with their own 'account_id'",
+				ConflictsWith: []string{"org_id"},
+			},
+
+			"api_base_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_API_BASE_URL", nil),
+				Description: "Configure the base URL of the Cloudflare API, e.g. to send requests through a proxy or to a test server",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"cloudflare_ip_ranges": dataSourceCloudflareIPRanges(),
-			"cloudflare_zones":     dataSourceCloudflareZones(),
+			"cloudflare_ip_ranges":             dataSourceCloudflareIPRanges(),
+			"cloudflare_record":                dataSourceCloudflareRecord(),
+			"cloudflare_records":               dataSourceCloudflareRecords(),
+			"cloudflare_virtual_dns_analytics": dataSourceCloudflareVirtualDNSAnalytics(),
+			"cloudflare_zone":                  dataSourceCloudflareZone(),
+			"cloudflare_zone_file":             dataSourceCloudflareZoneFile(),
+			"cloudflare_zones":                 dataSourceCloudflareZones(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"cloudflare_access_application":     resourceCloudflareAccessApplication(),
-			"cloudflare_access_policy":          resourceCloudflareAccessPolicy(),
-			"cloudflare_access_rule":            resourceCloudflareAccessRule(),
-			"cloudflare_account_member":         resourceCloudflareAccountMember(),
-			"cloudflare_custom_pages":           resourceCloudflareCustomPages(),
-			"cloudflare_filter":                 resourceCloudflareFilter(),
-			"cloudflare_firewall_rule":          resourceCloudflareFirewallRule(),
-			"cloudflare_load_balancer_monitor":  resourceCloudflareLoadBalancerMonitor(),
-			"cloudflare_load_balancer_pool":     resourceCloudflareLoadBalancerPool(),
-			"cloudflare_load_balancer":          resourceCloudflareLoadBalancer(),
-			"cloudflare_page_rule":              resourceCloudflarePageRule(),
-			"cloudflare_rate_limit":             resourceCloudflareRateLimit(),
-			"cloudflare_record":                 resourceCloudflareRecord(),
-			"cloudflare_spectrum_application":   resourceCloudflareSpectrumApplication(),
-			"cloudflare_waf_rule":               resourceCloudflareWAFRule(),
-			"cloudflare_worker_route":           resourceCloudflareWorkerRoute(),
-			"cloudflare_worker_script":          resourceCloudflareWorkerScript(),
-			"cloudflare_zone_lockdown":          resourceCloudflareZoneLockdown(),
-			"cloudflare_zone_settings_override": resourceCloudflareZoneSettingsOverride(),
-			"cloudflare_zone":                   resourceCloudflareZone(),
-			"cloudflare_virtual_dns":            resourceCloudflareVirtualDNS(),
+			"cloudflare_access_application":        resourceCloudflareAccessApplication(),
+			"cloudflare_access_policy":             resourceCloudflareAccessPolicy(),
+			"cloudflare_access_rule":               resourceCloudflareAccessRule(),
+			"cloudflare_account_custom_nameserver": resourceCloudflareAccountCustomNameserver(),
+			"cloudflare_account_member":            resourceCloudflareAccountMember(),
+			"cloudflare_custom_pages":              resourceCloudflareCustomPages(),
+			"cloudflare_filter":                    resourceCloudflareFilter(),
+			"cloudflare_firewall_rule":             resourceCloudflareFirewallRule(),
+			"cloudflare_load_balancer_monitor":     resourceCloudflareLoadBalancerMonitor(),
+			"cloudflare_load_balancer_pool":        resourceCloudflareLoadBalancerPool(),
+			"cloudflare_load_balancer":             resourceCloudflareLoadBalancer(),
+			"cloudflare_origin_ca_certificate":     resourceCloudflareOriginCACertificate(),
+			"cloudflare_page_rule":                 resourceCloudflarePageRule(),
+			"cloudflare_rate_limit":                resourceCloudflareRateLimit(),
+			"cloudflare_record":                    resourceCloudflareRecord(),
+			"cloudflare_spectrum_application":      resourceCloudflareSpectrumApplication(),
+			"cloudflare_waf_rule":                  resourceCloudflareWAFRule(),
+			"cloudflare_worker_route":              resourceCloudflareWorkerRoute(),
+			"cloudflare_worker_script":             resourceCloudflareWorkerScript(),
+			"cloudflare_zone_activation_check":     resourceCloudflareZoneActivationCheck(),
+			"cloudflare_zone_dnssec":               resourceCloudflareZoneDNSSEC(),
+			"cloudflare_zone_file":                 resourceCloudflareZoneFile(),
+			"cloudflare_zone_lockdown":             resourceCloudflareZoneLockdown(),
+			"cloudflare_zone_settings_override":    resourceCloudflareZoneSettingsOverride(),
+			"cloudflare_zone_transfer_incoming":    resourceCloudflareZoneTransferIncoming(),
+			"cloudflare_zone_transfer_outgoing":    resourceCloudflareZoneTransferOutgoing(),
+			"cloudflare_zone_transfer_peer":        resourceCloudflareZoneTransferPeer(),
+			"cloudflare_zone_transfer_tsig":        resourceCloudflareZoneTransferTSIG(),
+			"cloudflare_zone":                      resourceCloudflareZone(),
+			"cloudflare_virtual_dns":               resourceCloudflareVirtualDNS(),
 		},
+	}
 
-		ConfigureFunc: providerConfigure,
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider.StopContext())
 	}
+
+	return provider
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	limitOpt := cloudflare.UsingRateLimit(float64(d.Get("rps").(int)))
-	retryOpt := cloudflare.UsingRetryPolicy(d.Get("retries").(int), d.Get("min_backoff").(int), d.Get("max_backoff").(int))
-	options := []cloudflare.Option{limitOpt, retryOpt}
+func providerConfigure(d *schema.ResourceData, stopCtx context.Context) (interface{}, error) {
+	var retryableErrorCodes []int
+	for _, code := range d.Get("retryable_error_codes").([]interface{}) {
+		retryableErrorCodes = append(retryableErrorCodes, code.(int))
+	}
+
+	// Rate limiting and retries are done by the transport, where they can be
+	// cancelled, so they are turned off in the SDK.
+	transport := newRetryTransport(
+		logging.NewTransport("Cloudflare", cleanhttp.DefaultTransport()),
+		float64(d.Get("rps").(int)),
+		d.Get("retries").(int),
+		time.Duration(d.Get("min_backoff").(int))*time.Second,
+		time.Duration(d.Get("max_backoff").(int))*time.Second,
+		retryableErrorCodes,
+	)
+	options := []cloudflare.Option{
+		cloudflare.UsingRateLimit(float64(rate.Inf)),
+		cloudflare.UsingRetryPolicy(0, 0, 0),
+		cloudflare.HTTPClient(&http.Client{Transport: &contextTransport{ctx: stopCtx, base: transport}}),
+	}
 
 	if d.Get("api_client_logging").(bool) {
 		options = append(options, cloudflare.UsingLogger(log.New(os.Stderr, "", log.LstdFlags)))
 	}
 
-	c := cleanhttp.DefaultClient()
-	c.Transport = logging.NewTransport("Cloudflare", c.Transport)
-	options = append(options, cloudflare.HTTPClient(c))
+	if baseURL, ok := d.GetOk("api_base_url"); ok {
+		log.Printf("[INFO] Using Cloudflare API base URL %s", baseURL.(string))
+		options = append(options, usingBaseURL(baseURL.(string)))
+	}
 
 	config := Config{
-		Email:   d.Get("email").(string),
-		Token:   d.Get("token").(string),
-		Options: options,
+		Email:          d.Get("email").(string),
+		Token:          d.Get("token").(string),
+		APIToken:       d.Get("api_token").(string),
+		UserServiceKey: d.Get("user_service_key").(string),
+		Options:        options,
 	}
 
 	client, err := config.Client()
@@ -140,7 +223,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
-	if orgId, ok := d.GetOk("org_id"); ok {
+	if accountID, ok := d.GetOk("account_id"); ok {
+		log.Printf("[INFO] Using specified account id %s in Cloudflare provider", accountID.(string))
+		options = append(options, cloudflare.UsingOrganization(accountID.(string)))
+	} else if orgId, ok := d.GetOk("org_id"); ok {
 		log.Printf("[INFO] Using specified organization id %s in Cloudflare provider", orgId.(string))
 		options = append(options, cloudflare.UsingOrganization(orgId.(string)))
 	} else if zoneName, ok := d.GetOk("use_org_from_zone"); ok {
@@ -173,7 +259,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			log.Printf("[INFO] Zone ownership specified but organization owner not found. Falling back to using user API for Cloudflare provider")
 		}
 	} else {
-		return client, err
+		return newProviderClient(client, stopCtx, transport), nil
 	}
 
 	// TODO: This is the SDK version not the CLI version, once we are on 0.12, should revisit
@@ -184,9 +270,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	options = append(options, cloudflare.UserAgent(strings.TrimSpace(fmt.Sprintf("%s %s", client.UserAgent, providerUserAgent))))
 
 	config = Config{
-		Email:   d.Get("email").(string),
-		Token:   d.Get("token").(string),
-		Options: options,
+		Email:          d.Get("email").(string),
+		Token:          d.Get("token").(string),
+		APIToken:       d.Get("api_token").(string),
+		UserServiceKey: d.Get("user_service_key").(string),
+		Options:        options,
 	}
 
 	client, err = config.Client()
@@ -194,5 +282,5 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
-	return client, err
+	return newProviderClient(client, stopCtx, transport), nil
 }
@@ -2,10 +2,14 @@ package cloudflare
 
 import (
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-cloudflare/internal/fakeapi"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -18,6 +22,47 @@ func init() {
 	}
 }
 
+// TestMain points the acceptance tests at an in-memory fake of the Cloudflare
+// API when CLOUDFLARE_FAKE_API is set, so they can run without credentials or
+// network access.
+func TestMain(m *testing.M) {
+	if os.Getenv("CLOUDFLARE_FAKE_API") == "" {
+		os.Exit(m.Run())
+	}
+
+	server := startFakeAPI()
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+func startFakeAPI() *fakeapi.Server {
+	if os.Getenv("CLOUDFLARE_API_TOKEN") == "" {
+		setEnvDefault("CLOUDFLARE_EMAIL", "terraform-acctest@example.com")
+		setEnvDefault("CLOUDFLARE_TOKEN", "fake-api-key")
+	}
+	setEnvDefault("CLOUDFLARE_API_USER_SERVICE_KEY", "v1.0-fake-user-service-key")
+	setEnvDefault("CLOUDFLARE_DOMAIN", "terraform-acctest.example.com")
+	setEnvDefault("CLOUDFLARE_ALT_DOMAIN", "terraform-acctest-alt.example.com")
+	setEnvDefault("CLOUDFLARE_ORG_ID", "f037e56e89293a057740de681ac9abbe")
+	setEnvDefault("CLOUDFLARE_ALT_ACCOUNT_ID", "0b5ab7ef9c4d2e6f8a1b3c5d7e9f0a2b")
+
+	server := fakeapi.NewServer(
+		os.Getenv("CLOUDFLARE_ORG_ID"),
+		os.Getenv("CLOUDFLARE_DOMAIN"),
+		os.Getenv("CLOUDFLARE_ALT_DOMAIN"),
+	)
+	os.Setenv("CLOUDFLARE_API_BASE_URL", server.BaseURL())
+
+	return server
+}
+
+func setEnvDefault(key, value string) {
+	if os.Getenv(key) == "" {
+		os.Setenv(key, value)
+	}
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -28,15 +73,158 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProviderConfigure_APIBaseURL(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer server.Close()
+
+	p, err := testProviderConfigure(t, map[string]interface{}{
+		"email":        "user@example.com",
+		"token":        "fake-api-key",
+		"api_base_url": server.BaseURL() + "/",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	client := p.Meta().(*providerClient)
+	if client.BaseURL != server.BaseURL() {
+		t.Fatalf("expected base URL %q, got %q", server.BaseURL(), client.BaseURL)
+	}
+
+	if _, err := client.ZoneIDByName("example.com"); err != nil {
+		t.Fatalf("expected zone lookup against the configured base URL to succeed: %s", err)
+	}
+}
+
+func TestProviderConfigure_APIToken(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer server.Close()
+
+	p, err := testProviderConfigure(t, map[string]interface{}{
+		"api_token":    "fake-api-token",
+		"api_base_url": server.BaseURL(),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	client := p.Meta().(*providerClient)
+	if client.APIKey != "" || client.APIEmail != "" || client.APIUserServiceKey != "" {
+		t.Fatalf("expected no global API key credentials on the client, got %#v", client)
+	}
+
+	if _, err := client.ZoneIDByName("example.com"); err != nil {
+		t.Fatalf("expected zone lookup with an API token to succeed: %s", err)
+	}
+}
+
+func TestProviderConfigure_UserServiceKey(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer server.Close()
+
+	p, err := testProviderConfigure(t, map[string]interface{}{
+		"user_service_key": "v1.0-fake-user-service-key",
+		"api_base_url":     server.BaseURL(),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	client := p.Meta().(*providerClient)
+	if client.APIUserServiceKey != "v1.0-fake-user-service-key" {
+		t.Fatalf("expected the user service key to be configured, got %q", client.APIUserServiceKey)
+	}
+
+	if _, err := client.OriginCertificates(cloudflare.OriginCACertificateListOptions{}); err != nil {
+		t.Fatalf("expected listing origin certificates with a user service key to succeed: %s", err)
+	}
+}
+
+func TestProviderConfigure_InvalidCredentials(t *testing.T) {
+	cases := map[string]struct {
+		config   map[string]interface{}
+		expected string
+	}{
+		"none": {
+			config:   map[string]interface{}{},
+			expected: "no credentials configured",
+		},
+		"email without token": {
+			config:   map[string]interface{}{"email": "user@example.com"},
+			expected: "email and token must both be set",
+		},
+		"api token and global key": {
+			config:   map[string]interface{}{"api_token": "fake-api-token", "email": "user@example.com", "token": "fake-api-key"},
+			expected: "api_token cannot be used together with email and token",
+		},
+	}
+
+	for name, c := range cases {
+		_, err := testProviderConfigure(t, c.config)
+		if err == nil || !strings.Contains(err.Error(), c.expected) {
+			t.Errorf("%s: expected error containing %q, got %v", name, c.expected, err)
+		}
+	}
+}
+
+func TestProviderConfigure_AccountID(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer server.Close()
+
+	p, err := testProviderConfigure(t, map[string]interface{}{
+		"email":        "user@example.com",
+		"token":        "fake-api-key",
+		"account_id":   "f037e56e89293a057740de681ac9abbe",
+		"api_base_url": server.BaseURL(),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	client := p.Meta().(*providerClient)
+	if client.OrganizationID != "f037e56e89293a057740de681ac9abbe" {
+		t.Fatalf("expected the client to use account f037e56e89293a057740de681ac9abbe, got %q", client.OrganizationID)
+	}
+
+	other := client.withAccount("0b5ab7ef9c4d2e6f8a1b3c5d7e9f0a2b")
+	if other.OrganizationID != "0b5ab7ef9c4d2e6f8a1b3c5d7e9f0a2b" || client.OrganizationID != "f037e56e89293a057740de681ac9abbe" {
+		t.Fatalf("expected withAccount to return a copy for the other account, got %q and %q", other.OrganizationID, client.OrganizationID)
+	}
+	if client.withAccount("") != client {
+		t.Fatal("expected withAccount without an account to return the client itself")
+	}
+}
+
+// testProviderConfigure configures a new provider from raw, ignoring any
+// credentials set in the environment.
+func testProviderConfigure(t *testing.T, raw map[string]interface{}) (*schema.Provider, error) {
+	for _, k := range []string{"CLOUDFLARE_EMAIL", "CLOUDFLARE_TOKEN", "CLOUDFLARE_API_TOKEN", "CLOUDFLARE_API_USER_SERVICE_KEY", "CLOUDFLARE_ORG_ID", "CLOUDFLARE_ORG_ZONE", "CLOUDFLARE_ACCOUNT_ID"} {
+		if v, ok := os.LookupEnv(k); ok {
+			os.Unsetenv(k)
+			defer os.Setenv(k, v)
+		}
+	}
+
+	c, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	p := Provider().(*schema.Provider)
+	return p, p.Configure(terraform.NewResourceConfig(c))
+}
+
 type preCheckFunc = func(*testing.T)
 
 func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("CLOUDFLARE_EMAIL"); v == "" {
-		t.Fatal("CLOUDFLARE_EMAIL must be set for acceptance tests")
-	}
+	if v := os.Getenv("CLOUDFLARE_API_TOKEN"); v == "" {
+		if v := os.Getenv("CLOUDFLARE_EMAIL"); v == "" {
+			t.Fatal("CLOUDFLARE_EMAIL must be set for acceptance tests when CLOUDFLARE_API_TOKEN is not set")
+		}
 
-	if v := os.Getenv("CLOUDFLARE_TOKEN"); v == "" {
-		t.Fatal("CLOUDFLARE_TOKEN must be set for acceptance tests")
+		if v := os.Getenv("CLOUDFLARE_TOKEN"); v == "" {
+			t.Fatal("CLOUDFLARE_TOKEN must be set for acceptance tests when CLOUDFLARE_API_TOKEN is not set")
+		}
 	}
 
 	if v := os.Getenv("CLOUDFLARE_DOMAIN"); v == "" {
@@ -44,12 +232,32 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccPreCheckRealAPI skips tests that need endpoints the fake API can't
+// stand in for, such as ones at fixed URLs outside the configured base URL.
+func testAccPreCheckRealAPI(t *testing.T) {
+	if os.Getenv("CLOUDFLARE_FAKE_API") != "" {
+		t.Skip("CLOUDFLARE_FAKE_API is set and this acceptance test needs the real API")
+	}
+}
+
 func testAccPreCheckAltDomain(t *testing.T) {
 	if v := os.Getenv("CLOUDFLARE_ALT_DOMAIN"); v == "" {
 		t.Fatal("CLOUDFLARE_ALT_DOMAIN must be set for this acceptance test")
 	}
 }
 
+func testAccPreCheckUserServiceKey(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_API_USER_SERVICE_KEY"); v == "" {
+		t.Fatal("CLOUDFLARE_API_USER_SERVICE_KEY must be set for this acceptance test")
+	}
+}
+
+func testAccPreCheckAltAccount(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_ALT_ACCOUNT_ID"); v == "" {
+		t.Fatal("CLOUDFLARE_ALT_ACCOUNT_ID must be set for this acceptance test")
+	}
+}
+
 func testAccPreCheckOrg(t *testing.T) {
 	if v := os.Getenv("CLOUDFLARE_ORG_ID"); v == "" {
 		t.Fatal("CLOUDFLARE_ORG_ID must be set for this acceptance test")
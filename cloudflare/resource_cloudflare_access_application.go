@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,12 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 			State: resourceCloudflareAccessApplicationImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -48,7 +55,8 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 }
 
 func resourceCloudflareAccessApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 	newAccessApplication := cloudflare.AccessApplication{
 		Name:            d.Get("name").(string),
@@ -69,12 +77,12 @@ func resourceCloudflareAccessApplicationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceCloudflareAccessApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	accessApplication, err := client.AccessApplication(zoneID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Access Application %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -90,7 +98,8 @@ func resourceCloudflareAccessApplicationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceCloudflareAccessApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 	updatedAccessApplication := cloudflare.AccessApplication{
 		ID:              d.Id(),
@@ -114,14 +123,15 @@ func resourceCloudflareAccessApplicationUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceCloudflareAccessApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 	appID := d.Id()
 
 	log.Printf("[DEBUG] Deleting Cloudflare Access Application using ID: %s", appID)
 
 	err := client.DeleteAccessApplication(zoneID, appID)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("error deleting Access Application for zone %q: %s", zoneID, err)
 	}
 
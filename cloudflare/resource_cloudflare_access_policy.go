@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,12 @@ func resourceCloudflareAccessPolicy() *schema.Resource {
 			State: resourceCloudflareAccessPolicyImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Type:     schema.TypeString,
@@ -94,13 +101,13 @@ var policyOptionElement = &schema.Resource{
 }
 
 func resourceCloudflareAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	appID := d.Get("application_id").(string)
 
 	accessPolicy, err := client.AccessPolicy(zoneID, appID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Access Policy %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -119,7 +126,8 @@ func resourceCloudflareAccessPolicyRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	appID := d.Get("application_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	newAccessPolicy := cloudflare.AccessPolicy{
@@ -143,7 +151,8 @@ func resourceCloudflareAccessPolicyCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareAccessPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 	appID := d.Get("application_id").(string)
 	updatedAccessPolicy := cloudflare.AccessPolicy{
@@ -170,14 +179,15 @@ func resourceCloudflareAccessPolicyUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 	appID := d.Get("application_id").(string)
 
 	log.Printf("[DEBUG] Deleting Cloudflare Access Policy using ID: %s", d.Id())
 
 	err := client.DeleteAccessPolicy(zoneID, appID, d.Id())
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("error deleting Access Policy for ID %q: %s", d.Id(), err)
 	}
 
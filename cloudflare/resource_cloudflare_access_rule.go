@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,7 +21,20 @@ func resourceCloudflareAccessRule() *schema.Resource {
 			State: resourceCloudflareAccessRuleImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"zone", "zone_id"},
+			},
 			"zone": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,7 +79,8 @@ func resourceCloudflareAccessRule() *schema.Resource {
 }
 
 func resourceCloudflareAccessRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	zone := d.Get("zone").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -119,7 +134,7 @@ func resourceCloudflareAccessRuleCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareAccessRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
 	zoneID := d.Get("zone_id").(string)
 
 	var accessRuleResponse *cloudflare.AccessRuleResponse
@@ -137,7 +152,7 @@ func resourceCloudflareAccessRuleRead(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] accessRuleResponse error: %#v", err)
 
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Access Rule %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -147,6 +162,9 @@ func resourceCloudflareAccessRuleRead(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Cloudflare Access Rule read configuration: %#v", accessRuleResponse)
 
+	if zoneID == "" {
+		d.Set("account_id", client.OrganizationID)
+	}
 	d.Set("mode", accessRuleResponse.Result.Mode)
 	d.Set("notes", accessRuleResponse.Result.Notes)
 	log.Printf("[DEBUG] read configuration: %#v", d.Get("configuration"))
@@ -161,7 +179,8 @@ func resourceCloudflareAccessRuleRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCloudflareAccessRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	newRule := cloudflare.AccessRule{
@@ -199,7 +218,8 @@ func resourceCloudflareAccessRuleUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareAccessRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Access Rule: id %s for zone_id %s", d.Id(), zoneID)
@@ -216,7 +236,7 @@ func resourceCloudflareAccessRuleDelete(d *schema.ResourceData, meta interface{}
 		_, err = client.DeleteZoneAccessRule(zoneID, d.Id())
 	}
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error deleting Cloudflare Access Rule: %s", err)
 	}
 
@@ -224,7 +244,6 @@ func resourceCloudflareAccessRuleDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareAccessRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
 	attributes := strings.Split(d.Id(), "/")
 
 	var (
@@ -243,7 +262,7 @@ func resourceCloudflareAccessRuleImport(d *schema.ResourceData, meta interface{}
 
 	switch accessRuleType {
 	case "account":
-		client.OrganizationID = accessRuleTypeIdentifier
+		d.Set("account_id", accessRuleTypeIdentifier)
 	case "zone":
 		d.Set("zone_id", accessRuleTypeIdentifier)
 	}
@@ -0,0 +1,216 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// accountCustomNameserver is a custom nameserver of an account, which the SDK
+// doesn't support yet.
+type accountCustomNameserver struct {
+	NSName     string                          `json:"ns_name"`
+	NSSet      int                             `json:"ns_set,omitempty"`
+	Status     string                          `json:"status,omitempty"`
+	ZoneTag    string                          `json:"zone_tag,omitempty"`
+	DNSRecords []accountCustomNameserverRecord `json:"dns_records,omitempty"`
+}
+
+// accountCustomNameserverRecord is a glue record of a custom nameserver.
+type accountCustomNameserverRecord struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func resourceCloudflareAccountCustomNameserver() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareAccountCustomNameserverCreate,
+		Read:   resourceCloudflareAccountCustomNameserverRead,
+		Delete: resourceCloudflareAccountCustomNameserverDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareAccountScopedImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ns_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(i interface{}) string {
+					return strings.TrimSuffix(strings.ToLower(i.(string)), ".")
+				},
+			},
+			"ns_set": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ipv4_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ipv6_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCloudflareAccountCustomNameserverCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	path, err := accountCustomNameserverPath(client)
+	if err != nil {
+		return err
+	}
+
+	nameserver := accountCustomNameserver{
+		NSName: d.Get("ns_name").(string),
+		NSSet:  d.Get("ns_set").(int),
+	}
+
+	log.Printf("[INFO] Creating Cloudflare custom nameserver %q in set %d", nameserver.NSName, nameserver.NSSet)
+
+	res, err := client.Raw("POST", path, nameserver)
+	if err != nil {
+		return fmt.Errorf("Error creating custom nameserver %q: %s", nameserver.NSName, err)
+	}
+	if err := json.Unmarshal(res, &nameserver); err != nil {
+		return fmt.Errorf("Error decoding custom nameserver %q: %s", nameserver.NSName, err)
+	}
+
+	d.SetId(nameserver.NSName)
+
+	return resourceCloudflareAccountCustomNameserverRead(d, meta)
+}
+
+func resourceCloudflareAccountCustomNameserverRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
+
+	path, err := accountCustomNameserverPath(client)
+	if err != nil {
+		return err
+	}
+
+	var nameservers []accountCustomNameserver
+	res, err := client.Raw("GET", path, nil)
+	if err == nil {
+		err = json.Unmarshal(res, &nameservers)
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing custom nameservers: %s", err)
+	}
+
+	var nameserver *accountCustomNameserver
+	for i := range nameservers {
+		if strings.EqualFold(nameservers[i].NSName, d.Id()) {
+			nameserver = &nameservers[i]
+		}
+	}
+	if nameserver == nil {
+		log.Printf("[WARN] Removing custom nameserver %q from state because it's not present in API", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	records := make([]map[string]interface{}, 0, len(nameserver.DNSRecords))
+	ipv4, ipv6 := []string{}, []string{}
+	for _, record := range nameserver.DNSRecords {
+		records = append(records, map[string]interface{}{
+			"type":  record.Type,
+			"value": record.Value,
+		})
+		switch record.Type {
+		case "A":
+			ipv4 = append(ipv4, record.Value)
+		case "AAAA":
+			ipv6 = append(ipv6, record.Value)
+		}
+	}
+
+	d.Set("account_id", client.OrganizationID)
+	d.Set("ns_name", nameserver.NSName)
+	d.Set("ns_set", nameserver.NSSet)
+	d.Set("status", nameserver.Status)
+	d.Set("zone_id", nameserver.ZoneTag)
+	if err := d.Set("dns_records", records); err != nil {
+		return fmt.Errorf("Error setting dns_records: %s", err)
+	}
+	d.Set("ipv4_addresses", ipv4)
+	d.Set("ipv6_addresses", ipv6)
+
+	return nil
+}
+
+func resourceCloudflareAccountCustomNameserverDelete(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	path, err := accountCustomNameserverPath(client)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Cloudflare custom nameserver %q", d.Id())
+
+	_, err = client.Raw("DELETE", path+"/"+d.Id(), nil)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("Error deleting custom nameserver %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func accountCustomNameserverPath(client *providerClient) (string, error) {
+	if client.OrganizationID == "" {
+		return "", fmt.Errorf("account_id must be set on the resource or the provider to manage custom nameservers")
+	}
+
+	return "/accounts/" + client.OrganizationID + "/custom_ns", nil
+}
@@ -0,0 +1,114 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareAccountCustomNameserver_Basic(t *testing.T) {
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneName := "tf-acctest-custom-ns.example.net"
+	rnd := acctest.RandString(10)
+	ns1 := fmt.Sprintf("ns1-%s.%s", rnd, domain)
+	ns2 := fmt.Sprintf("ns2-%s.%s", rnd, domain)
+	name := "cloudflare_account_custom_nameserver.ns1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareAccountCustomNameserverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccountCustomNameserverConfig(zoneName, ns1, ns2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ns_name", ns1),
+					resource.TestCheckResourceAttr(name, "ns_set", "2"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					resource.TestCheckResourceAttrSet(name, "zone_id"),
+					resource.TestCheckResourceAttr(name, "dns_records.#", "2"),
+					resource.TestCheckResourceAttr(name, "dns_records.0.type", "A"),
+					resource.TestCheckResourceAttr(name, "ipv4_addresses.#", "1"),
+					resource.TestMatchResourceAttr(name, "ipv4_addresses.0", regexp.MustCompile(`^\d+\.\d+\.\d+\.\d+$`)),
+					resource.TestCheckResourceAttr(name, "ipv6_addresses.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "ipv4_addresses.0", name, "dns_records.0.value"),
+					resource.TestCheckResourceAttr("cloudflare_zone.test", "custom_name_servers_enabled", "true"),
+					resource.TestCheckResourceAttr("cloudflare_zone.test", "custom_name_servers_set", "2"),
+					resource.TestCheckResourceAttr("cloudflare_zone.test", "name_servers.#", "2"),
+					resource.TestCheckResourceAttr("cloudflare_zone.test", "name_servers.0", ns1),
+					resource.TestCheckResourceAttr("cloudflare_zone.test", "name_servers.1", ns2),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudflareAccountCustomNameserverConfig(zoneName, ns1, ns2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudflare_zone.test", "custom_name_servers_enabled", "false"),
+					resource.TestMatchResourceAttr("cloudflare_zone.test", "name_servers.0", regexp.MustCompile(`\.ns\.cloudflare\.com$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareAccountCustomNameserverDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_account_custom_nameserver" {
+			continue
+		}
+
+		path, err := accountCustomNameserverPath(client.withAccount(rs.Primary.Attributes["account_id"]))
+		if err != nil {
+			return err
+		}
+		res, err := client.Raw("GET", path, nil)
+		if err != nil {
+			return err
+		}
+		var nameservers []accountCustomNameserver
+		if err := json.Unmarshal(res, &nameservers); err != nil {
+			return err
+		}
+		for _, nameserver := range nameservers {
+			if strings.EqualFold(nameserver.NSName, rs.Primary.ID) {
+				return fmt.Errorf("custom nameserver %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudflareAccountCustomNameserverConfig(zoneName, ns1, ns2 string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_account_custom_nameserver" "ns1" {
+  ns_name = "%[2]s"
+  ns_set  = 2
+}
+
+resource "cloudflare_account_custom_nameserver" "ns2" {
+  ns_name = "%[3]s"
+  ns_set  = 2
+}
+
+resource "cloudflare_zone" "test" {
+  zone                        = "%[1]s"
+  custom_name_servers_enabled = %[4]t
+  custom_name_servers_set     = 2
+
+  depends_on = ["cloudflare_account_custom_nameserver.ns1", "cloudflare_account_custom_nameserver.ns2"]
+}`, zoneName, ns1, ns2, enabled)
+}
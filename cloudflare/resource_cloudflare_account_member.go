@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,7 +21,20 @@ func resourceCloudflareAccountMember() *schema.Resource {
 		},
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"email_address": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -44,12 +58,11 @@ func resourceCloudflareAccountMember() *schema.Resource {
 }
 
 func resourceCloudflareAccountMemberRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
 
 	_, err := client.AccountMember(client.OrganizationID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Member not found") ||
-			strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[WARN] Removing account member from state because it's not present in API")
 			d.SetId("")
 			return nil
@@ -57,18 +70,20 @@ func resourceCloudflareAccountMemberRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	d.Set("account_id", client.OrganizationID)
 	d.SetId(d.Id())
 
 	return nil
 }
 
 func resourceCloudflareAccountMemberDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	log.Printf("[INFO] Deleting Cloudflare account member ID: %s", d.Id())
 
 	err := client.DeleteAccountMember(client.OrganizationID, d.Id())
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("error deleting Cloudflare account member: %s", err)
 	}
 
@@ -79,7 +94,12 @@ func resourceCloudflareAccountMemberCreate(d *schema.ResourceData, meta interfac
 	memberEmailAddress := d.Get("email_address").(string)
 	requestedMemberRoles := d.Get("role_ids").([]interface{})
 
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if client.OrganizationID == "" {
+		return fmt.Errorf("account_id must be set on the resource or the provider to create an account member")
+	}
 
 	var accountMemberRoleIDs []string
 	for _, roleID := range requestedMemberRoles {
@@ -102,7 +122,8 @@ func resourceCloudflareAccountMemberCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceCloudflareAccountMemberUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	accountRoles := []cloudflare.AccountRole{}
 	memberRoles := d.Get("role_ids").([]interface{})
 
@@ -121,7 +142,7 @@ func resourceCloudflareAccountMemberUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceCloudflareAccountMemberImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
 
 	// split the id so we can lookup the account member
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -136,6 +157,9 @@ func resourceCloudflareAccountMemberImport(d *schema.ResourceData, meta interfac
 
 	member, err := client.AccountMember(accountID, accountMemberID)
 	if err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("account member %q does not exist in account %q", accountMemberID, accountID)
+		}
 		return nil, fmt.Errorf("unable to find account member with ID %q: %q", accountMemberID, err)
 	}
 
@@ -146,6 +170,7 @@ func resourceCloudflareAccountMemberImport(d *schema.ResourceData, meta interfac
 		memberIDs = append(memberIDs, role.ID)
 	}
 
+	d.Set("account_id", accountID)
 	d.Set("email_address", member.User.Email)
 	d.Set("role_ids", memberIDs)
 	d.SetId(accountMemberID)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -25,6 +26,12 @@ func resourceCloudflareCustomPages() *schema.Resource {
 			State: resourceCloudflareCustomPagesImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:          schema.TypeString,
@@ -34,6 +41,7 @@ func resourceCloudflareCustomPages() *schema.Resource {
 			"account_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ConflictsWith: []string{"zone_id"},
 			},
 			"type": {
@@ -66,25 +74,21 @@ func resourceCloudflareCustomPages() *schema.Resource {
 }
 
 func resourceCloudflareCustomPagesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
-	zoneID := d.Get("zone_id").(string)
-	accountID := d.Get("account_id").(string)
+	client := meta.(*providerClient)
 	pageType := d.Get("type").(string)
 
-	if accountID == "" && zoneID == "" {
-		return fmt.Errorf("either `account_id` or `zone_id` must be set")
-	}
-
-	var pageOptions cloudflare.CustomPageOptions
-
-	if accountID != "" {
-		pageOptions = cloudflare.CustomPageOptions{AccountID: accountID}
-	} else {
-		pageOptions = cloudflare.CustomPageOptions{ZoneID: zoneID}
+	pageOptions, err := customPageOptions(d, client)
+	if err != nil {
+		return err
 	}
 
 	page, err := client.CustomPage(&pageOptions, pageType)
 	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[INFO] Custom page %s no longer exists", pageType)
+			d.SetId("")
+			return nil
+		}
 		return errors.New(err.Error())
 	}
 
@@ -97,6 +101,7 @@ func resourceCloudflareCustomPagesRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 
+	d.Set("account_id", pageOptions.AccountID)
 	d.Set("state", page.State)
 	d.Set("url", page.URL)
 	d.Set("type", page.ID)
@@ -105,15 +110,16 @@ func resourceCloudflareCustomPagesRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCloudflareCustomPagesUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
-	accountID := d.Get("account_id").(string)
-	zoneID := d.Get("zone_id").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	client, cancel := meta.(*providerClient).withTimeout(timeout)
+	defer cancel()
 
-	var pageOptions cloudflare.CustomPageOptions
-	if accountID != "" {
-		pageOptions = cloudflare.CustomPageOptions{AccountID: accountID}
-	} else {
-		pageOptions = cloudflare.CustomPageOptions{ZoneID: zoneID}
+	pageOptions, err := customPageOptions(d, client)
+	if err != nil {
+		return err
 	}
 
 	pageType := d.Get("type").(string)
@@ -121,7 +127,7 @@ func resourceCloudflareCustomPagesUpdate(d *schema.ResourceData, meta interface{
 		URL:   d.Get("url").(string),
 		State: "customized",
 	}
-	_, err := client.UpdateCustomPage(&pageOptions, pageType, customPageParameters)
+	_, err = client.UpdateCustomPage(&pageOptions, pageType, customPageParameters)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("failed to update '%s' custom page", pageType))
 	}
@@ -130,15 +136,12 @@ func resourceCloudflareCustomPagesUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceCloudflareCustomPagesDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
-	accountID := d.Get("account_id").(string)
-	zoneID := d.Get("zoneID").(string)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
-	var pageOptions cloudflare.CustomPageOptions
-	if accountID != "" {
-		pageOptions = cloudflare.CustomPageOptions{AccountID: accountID}
-	} else {
-		pageOptions = cloudflare.CustomPageOptions{ZoneID: zoneID}
+	pageOptions, err := customPageOptions(d, client)
+	if err != nil {
+		return err
 	}
 
 	pageType := d.Get("type").(string)
@@ -146,7 +149,7 @@ func resourceCloudflareCustomPagesDelete(d *schema.ResourceData, meta interface{
 		URL:   nil,
 		State: "default",
 	}
-	_, err := client.UpdateCustomPage(&pageOptions, pageType, customPageParameters)
+	_, err = client.UpdateCustomPage(&pageOptions, pageType, customPageParameters)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("failed to update '%s' custom page", pageType))
 	}
@@ -154,6 +157,24 @@ func resourceCloudflareCustomPagesDelete(d *schema.ResourceData, meta interface{
 	return resourceCloudflareCustomPagesRead(d, meta)
 }
 
+// customPageOptions returns the zone or the account of a custom page. Pages
+// with neither set belong to the provider's account.
+func customPageOptions(d *schema.ResourceData, client *providerClient) (cloudflare.CustomPageOptions, error) {
+	if zoneID := d.Get("zone_id").(string); zoneID != "" {
+		return cloudflare.CustomPageOptions{ZoneID: zoneID}, nil
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		accountID = client.OrganizationID
+	}
+	if accountID == "" {
+		return cloudflare.CustomPageOptions{}, fmt.Errorf("either `account_id` or `zone_id` must be set, on the resource or as `account_id` on the provider")
+	}
+
+	return cloudflare.CustomPageOptions{AccountID: accountID}, nil
+}
+
 func resourceCloudflareCustomPagesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 3)
 	if len(attributes) != 3 {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,12 @@ func resourceCloudflareFilter() *schema.Resource {
 			State: resourceCloudflareFilterImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:     schema.TypeString,
@@ -56,7 +63,8 @@ func resourceCloudflareFilter() *schema.Resource {
 }
 
 func resourceCloudflareFilterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	zoneName := d.Get("zone").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -110,7 +118,7 @@ func resourceCloudflareFilterCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceCloudflareFilterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	zoneName := d.Get("zone").(string)
 
@@ -136,7 +144,7 @@ func resourceCloudflareFilterRead(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] filter error: %#v", err)
 
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Filter %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -157,7 +165,8 @@ func resourceCloudflareFilterRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCloudflareFilterUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	var newFilter cloudflare.Filter
@@ -195,14 +204,15 @@ func resourceCloudflareFilterUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceCloudflareFilterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Filter: id %s for zone %s", d.Id(), zoneID)
 
 	err := client.DeleteFilter(zoneID, d.Id())
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error deleting Cloudflare Filter: %s", err)
 	}
 
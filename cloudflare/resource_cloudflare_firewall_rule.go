@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,12 @@ func resourceCloudflareFirewallRule() *schema.Resource {
 			State: resourceCloudflareFirewallRuleImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:     schema.TypeString,
@@ -61,7 +68,8 @@ func resourceCloudflareFirewallRule() *schema.Resource {
 }
 
 func resourceCloudflareFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	zoneName := d.Get("zone").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -123,7 +131,7 @@ func resourceCloudflareFirewallRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	zoneName := d.Get("zone").(string)
 
@@ -148,7 +156,7 @@ func resourceCloudflareFirewallRuleRead(d *schema.ResourceData, meta interface{}
 	log.Printf("[DEBUG] firewallRule error: %#v", err)
 
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Firewall Rule %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -170,7 +178,8 @@ func resourceCloudflareFirewallRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareFirewallRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	var newFirewallRule cloudflare.FirewallRule
@@ -214,14 +223,15 @@ func resourceCloudflareFirewallRuleUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Firewall Rule: id %s for zone %s", d.Id(), zoneID)
 
 	err := client.DeleteFirewallRule(zoneID, d.Id())
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error deleting Cloudflare Firewall Rule: %s", err)
 	}
 
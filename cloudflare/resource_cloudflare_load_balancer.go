@@ -24,6 +24,12 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 		},
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:     schema.TypeString,
@@ -163,7 +169,8 @@ var localPoolElems = map[string]*schema.Resource{
 }
 
 func resourceCloudflareLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	newLoadBalancer := cloudflare.LoadBalancer{
 		Name:           d.Get("name").(string),
@@ -226,7 +233,8 @@ func resourceCloudflareLoadBalancerCreate(d *schema.ResourceData, meta interface
 
 func resourceCloudflareLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
 	// since api only supports replace, update looks a lot like create...
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	loadBalancer := cloudflare.LoadBalancer{
@@ -287,13 +295,13 @@ func expandGeoPools(pool interface{}, geoType string) (map[string][]string, erro
 }
 
 func resourceCloudflareLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	loadBalancerID := d.Id()
 
 	loadBalancer, err := client.LoadBalancerDetails(zoneID, loadBalancerID)
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Load balancer %s in zone %s not found", loadBalancerID, zoneID)
 			d.SetId("")
 			return nil
@@ -340,14 +348,15 @@ func flattenGeoPools(pools map[string][]string, geoType string) *schema.Set {
 }
 
 func resourceCloudflareLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 	loadBalancerID := d.Id()
 
 	log.Printf("[INFO] Deleting Cloudflare Load Balancer: %s in zone: %s", loadBalancerID, zoneID)
 
 	err := client.DeleteLoadBalancer(zoneID, loadBalancerID)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("error deleting Cloudflare Load Balancer: %s", err)
 	}
 
@@ -355,7 +364,7 @@ func resourceCloudflareLoadBalancerDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareLoadBalancerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -3,7 +3,6 @@ package cloudflare
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"time"
 
@@ -20,11 +19,24 @@ func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
 		Update: resourceCloudflareLoadBalancerPoolMonitorUpdate,
 		Delete: resourceCloudflareLoadBalancerPoolMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceCloudflareAccountScopedImport,
 		},
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"expected_body": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -133,7 +145,8 @@ var monitorHeaderElem = &schema.Resource{
 }
 
 func resourceCloudflareLoadBalancerPoolMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	loadBalancerMonitor := cloudflare.LoadBalancerMonitor{
 		ExpectedBody:  d.Get("expected_body").(string),
@@ -185,7 +198,8 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(d *schema.ResourceData, met
 }
 
 func resourceCloudflareLoadBalancerPoolMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	loadBalancerMonitor := cloudflare.LoadBalancerMonitor{
 		ID:            d.Id(),
@@ -242,11 +256,11 @@ func expandLoadBalancerMonitorHeader(cfgSet interface{}) map[string][]string {
 }
 
 func resourceCloudflareLoadBalancerPoolMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
 
 	loadBalancerMonitor, err := client.LoadBalancerMonitorDetails(d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Load balancer monitor %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -257,6 +271,7 @@ func resourceCloudflareLoadBalancerPoolMonitorRead(d *schema.ResourceData, meta
 	}
 	log.Printf("[DEBUG] Read Cloudflare Load Balancer Monitor from API as struct: %+v", loadBalancerMonitor)
 
+	d.Set("account_id", client.OrganizationID)
 	d.Set("expected_body", loadBalancerMonitor.ExpectedBody)
 	d.Set("expected_codes", loadBalancerMonitor.ExpectedCodes)
 	d.Set("method", loadBalancerMonitor.Method)
@@ -292,13 +307,14 @@ func flattenLoadBalancerMonitorHeader(header map[string][]string) *schema.Set {
 }
 
 func resourceCloudflareLoadBalancerPoolMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	log.Printf("[INFO] Deleting Cloudflare Load Balancer Monitor: %s ", d.Id())
 
 	err := client.DeleteLoadBalancerMonitor(d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Load balancer monitor %s no longer exists", d.Id())
 			return nil
 		} else {
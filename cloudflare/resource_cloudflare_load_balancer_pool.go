@@ -3,7 +3,6 @@ package cloudflare
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"time"
 
@@ -22,11 +21,24 @@ func resourceCloudflareLoadBalancerPool() *schema.Resource {
 		Read:   resourceCloudflareLoadBalancerPoolRead,
 		Delete: resourceCloudflareLoadBalancerPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceCloudflareAccountScopedImport,
 		},
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -122,7 +134,8 @@ var originsElem = &schema.Resource{
 }
 
 func resourceCloudflareLoadBalancerPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	loadBalancerPool := cloudflare.LoadBalancerPool{
 		Name:           d.Get("name").(string),
@@ -166,7 +179,8 @@ func resourceCloudflareLoadBalancerPoolCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceCloudflareLoadBalancerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	loadBalancerPool := cloudflare.LoadBalancerPool{
 		ID:             d.Id(),
@@ -217,11 +231,11 @@ func expandLoadBalancerOrigins(originSet *schema.Set) (origins []cloudflare.Load
 }
 
 func resourceCloudflareLoadBalancerPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
 
 	loadBalancerPool, err := client.LoadBalancerPoolDetails(d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Load balancer pool %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -232,6 +246,7 @@ func resourceCloudflareLoadBalancerPoolRead(d *schema.ResourceData, meta interfa
 	}
 	log.Printf("[DEBUG] Read Cloudflare Load Balancer Pool from API as struct: %+v", loadBalancerPool)
 
+	d.Set("account_id", client.OrganizationID)
 	d.Set("name", loadBalancerPool.Name)
 	d.Set("enabled", loadBalancerPool.Enabled)
 	d.Set("minimum_origins", loadBalancerPool.MinimumOrigins)
@@ -267,12 +282,13 @@ func flattenLoadBalancerOrigins(origins []cloudflare.LoadBalancerOrigin) *schema
 }
 
 func resourceCloudflareLoadBalancerPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	log.Printf("[INFO] Deleting Cloudflare Load Balancer Pool: %s ", d.Id())
 
 	err := client.DeleteLoadBalancerPool(d.Id())
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.Wrap(err, "error deleting Cloudflare Load Balancer Pool")
 	}
 
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"time"
@@ -103,15 +104,58 @@ func TestAccCloudflareLoadBalancerPool_CreateAfterManualDestroy(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancerPool_AccountID(t *testing.T) {
+	t.Parallel()
+	var loadBalancerPool cloudflare.LoadBalancerPool
+	rnd := acctest.RandString(10)
+	name := "cloudflare_load_balancer_pool." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ALT_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAltAccount(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareLoadBalancerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerPoolConfigAccountID(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerPoolExists(name, &loadBalancerPool),
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCloudflareLoadBalancerPoolAccountImportID(name),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareLoadBalancerPoolAccountImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["account_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
 func testAccCheckCloudflareLoadBalancerPoolDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*providerClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_load_balancer_pool" {
 			continue
 		}
 
-		_, err := client.LoadBalancerPoolDetails(rs.Primary.ID)
+		_, err := client.withAccount(rs.Primary.Attributes["account_id"]).LoadBalancerPoolDetails(rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Load balancer pool still exists")
 		}
@@ -131,7 +175,7 @@ func testAccCheckCloudflareLoadBalancerPoolExists(n string, loadBalancerPool *cl
 			return fmt.Errorf("No Load Balancer ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient).withAccount(rs.Primary.Attributes["account_id"])
 		foundLoadBalancerPool, err := client.LoadBalancerPoolDetails(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -173,7 +217,7 @@ func testAccCheckCloudflareLoadBalancerPoolDates(n string, loadBalancerPool *clo
 
 func testAccManuallyDeleteLoadBalancerPool(name string, loadBalancerPool *cloudflare.LoadBalancerPool, initialId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		*initialId = loadBalancerPool.ID
 		err := client.DeleteLoadBalancerPool(loadBalancerPool.ID)
 		if err != nil {
@@ -196,6 +240,19 @@ resource "cloudflare_load_balancer_pool" "%[1]s" {
 }`, id)
 }
 
+func testAccCheckCloudflareLoadBalancerPoolConfigAccountID(id, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  account_id = "%[2]s"
+  name = "my-tf-pool-account-%[1]s"
+  origins {
+    name = "example-1"
+    address = "192.0.2.1"
+    enabled = true
+  }
+}`, id, accountID)
+}
+
 func testAccCheckCloudflareLoadBalancerPoolConfigFullySpecified(id string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_load_balancer_pool" "%[1]s" {
@@ -0,0 +1,237 @@
+package cloudflare
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceCloudflareOriginCACertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareOriginCACertificateCreate,
+		Read:   resourceCloudflareOriginCACertificateRead,
+		Update: resourceCloudflareOriginCACertificateUpdate,
+		Delete: resourceCloudflareOriginCACertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceCloudflareOriginCACertificateCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"csr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"hostnames": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"request_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"origin-rsa", "origin-ecc", "keyless-certificate"}, false),
+			},
+			"requested_validity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{7, 30, 90, 365, 730, 1095, 5475}),
+			},
+			"min_days_for_renewal": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareOriginCACertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	requestType := d.Get("request_type").(string)
+
+	hostnames := []string{}
+	for _, h := range d.Get("hostnames").(*schema.Set).List() {
+		hostnames = append(hostnames, h.(string))
+	}
+
+	csr := d.Get("csr").(string)
+	if csr == "" {
+		privateKey, generatedCSR, err := generateOriginCACertificateRequest(requestType, hostnames)
+		if err != nil {
+			return fmt.Errorf("error generating certificate signing request: %s", err)
+		}
+		csr = generatedCSR
+		d.Set("private_key", privateKey)
+	}
+
+	certificate := cloudflare.OriginCACertificate{
+		Hostnames:       hostnames,
+		RequestType:     requestType,
+		RequestValidity: d.Get("requested_validity").(int),
+		CSR:             csr,
+	}
+
+	log.Printf("[DEBUG] Creating Cloudflare Origin CA certificate for %v", hostnames)
+
+	cert, err := client.CreateOriginCertificate(certificate)
+	if err != nil {
+		return fmt.Errorf("error creating origin certificate for %v: %s", hostnames, err)
+	}
+
+	d.SetId(cert.ID)
+	d.Set("csr", csr)
+
+	log.Printf("[INFO] Cloudflare Origin CA certificate ID: %s", d.Id())
+
+	return resourceCloudflareOriginCACertificateRead(d, meta)
+}
+
+func resourceCloudflareOriginCACertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient)
+
+	cert, err := client.OriginCertificate(d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[INFO] Origin CA certificate %s not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error finding origin certificate %q: %s", d.Id(), err)
+	}
+
+	d.Set("certificate", cert.Certificate)
+	d.Set("hostnames", cert.Hostnames)
+	d.Set("request_type", cert.RequestType)
+	d.Set("expires_on", cert.ExpiresOn.Format(time.RFC3339))
+
+	if cert.RequestValidity != 0 {
+		d.Set("requested_validity", cert.RequestValidity)
+	}
+	if cert.CSR != "" {
+		d.Set("csr", cert.CSR)
+	}
+
+	return nil
+}
+
+// resourceCloudflareOriginCACertificateUpdate only exists so that
+// min_days_for_renewal can be changed without replacing the certificate,
+// every other argument forces a new resource.
+func resourceCloudflareOriginCACertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceCloudflareOriginCACertificateRead(d, meta)
+}
+
+func resourceCloudflareOriginCACertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	log.Printf("[INFO] Revoking Cloudflare Origin CA certificate %s", d.Id())
+
+	_, err := client.RevokeOriginCertificate(d.Id())
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("error revoking origin certificate %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceCloudflareOriginCACertificateCustomizeDiff plans a replacement of
+// the certificate once it expires within min_days_for_renewal days.
+func resourceCloudflareOriginCACertificateCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	minDays := d.Get("min_days_for_renewal").(int)
+	expiresOn := d.Get("expires_on").(string)
+	if minDays <= 0 || expiresOn == "" || d.Id() == "" {
+		return nil
+	}
+
+	expires, err := time.Parse(time.RFC3339, expiresOn)
+	if err != nil {
+		return fmt.Errorf("error parsing expires_on %q: %s", expiresOn, err)
+	}
+
+	if time.Until(expires) < time.Duration(minDays)*24*time.Hour {
+		log.Printf("[INFO] Origin CA certificate %s expires on %s, within %d days, planning renewal", d.Id(), expiresOn, minDays)
+		return d.SetNewComputed("expires_on")
+	}
+
+	return nil
+}
+
+// generateOriginCACertificateRequest creates a private key matching the
+// request type and a PEM encoded certificate signing request for hostnames.
+func generateOriginCACertificateRequest(requestType string, hostnames []string) (string, string, error) {
+	var key crypto.Signer
+	var keyBlock *pem.Block
+
+	switch requestType {
+	case "origin-ecc":
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return "", "", err
+		}
+		der, err := x509.MarshalECPrivateKey(ecKey)
+		if err != nil {
+			return "", "", err
+		}
+		key, keyBlock = ecKey, &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	default:
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return "", "", err
+		}
+		key, keyBlock = rsaKey, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}
+	}
+
+	template := &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: hostnames[0]},
+		DNSNames: hostnames,
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return "", "", err
+	}
+
+	csr := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+	return string(pem.EncodeToMemory(keyBlock)), string(csr), nil
+}
@@ -0,0 +1,154 @@
+package cloudflare
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareOriginCACertificate_Basic(t *testing.T) {
+	rnd := acctest.RandString(10)
+	name := "cloudflare_origin_ca_certificate." + rnd
+	hostname := rnd + "." + os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckUserServiceKey(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareOriginCACertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareOriginCACertificateConfig(rnd, hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostnames.#", "1"),
+					resource.TestCheckResourceAttr(name, "request_type", "origin-ecc"),
+					resource.TestCheckResourceAttr(name, "requested_validity", "7"),
+					resource.TestCheckResourceAttrSet(name, "certificate"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+					resource.TestMatchResourceAttr(name, "csr", regexp.MustCompile("BEGIN CERTIFICATE REQUEST")),
+					resource.TestMatchResourceAttr(name, "private_key", regexp.MustCompile("BEGIN EC PRIVATE KEY")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareOriginCACertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_origin_ca_certificate" {
+			continue
+		}
+
+		_, err := client.OriginCertificate(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Origin CA certificate %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudflareOriginCACertificateConfig(resourceID, hostname string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_origin_ca_certificate" "%[1]s" {
+  hostnames          = ["%[2]s"]
+  request_type       = "origin-ecc"
+  requested_validity = 7
+}`, resourceID, hostname)
+}
+
+func TestResourceCloudflareOriginCACertificate_RenewalWindow(t *testing.T) {
+	cases := map[string]struct {
+		expiresIn   time.Duration
+		minDays     int
+		requiresNew bool
+	}{
+		"outside window": {expiresIn: 60 * 24 * time.Hour, minDays: 30, requiresNew: false},
+		"inside window":  {expiresIn: 10 * 24 * time.Hour, minDays: 30, requiresNew: true},
+		"no window":      {expiresIn: 10 * 24 * time.Hour, minDays: 0, requiresNew: false},
+	}
+
+	for name, c := range cases {
+		hostname := "example.com"
+		state := &terraform.InstanceState{
+			ID: "328578533902268680484521",
+			Attributes: map[string]string{
+				"id":          "328578533902268680484521",
+				"csr":         "-----BEGIN CERTIFICATE REQUEST-----",
+				"hostnames.#": "1",
+				"hostnames." + strconv.Itoa(schema.HashString(hostname)): hostname,
+				"request_type":         "origin-rsa",
+				"requested_validity":   "5475",
+				"min_days_for_renewal": strconv.Itoa(c.minDays),
+				"certificate":          "-----BEGIN CERTIFICATE-----",
+				"expires_on":           time.Now().Add(c.expiresIn).UTC().Format(time.RFC3339),
+			},
+		}
+
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"csr":                  "-----BEGIN CERTIFICATE REQUEST-----",
+			"hostnames":            []interface{}{hostname},
+			"request_type":         "origin-rsa",
+			"requested_validity":   5475,
+			"min_days_for_renewal": c.minDays,
+		})
+		if err != nil {
+			t.Fatalf("%s: err: %s", name, err)
+		}
+
+		diff, err := resourceCloudflareOriginCACertificate().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("%s: err: %s", name, err)
+		}
+
+		if requiresNew := diff != nil && diff.RequiresNew(); requiresNew != c.requiresNew {
+			t.Errorf("%s: expected requires new to be %t, got diff %#v", name, c.requiresNew, diff)
+		}
+	}
+}
+
+func TestGenerateOriginCACertificateRequest(t *testing.T) {
+	cases := map[string]string{
+		"origin-rsa": "RSA PRIVATE KEY",
+		"origin-ecc": "EC PRIVATE KEY",
+	}
+
+	for requestType, keyType := range cases {
+		key, csr, err := generateOriginCACertificateRequest(requestType, []string{"example.com", "*.example.com"})
+		if err != nil {
+			t.Fatalf("%s: err: %s", requestType, err)
+		}
+
+		if block, _ := pem.Decode([]byte(key)); block == nil || block.Type != keyType {
+			t.Errorf("%s: expected a %s, got %q", requestType, keyType, key)
+		}
+
+		block, _ := pem.Decode([]byte(csr))
+		if block == nil {
+			t.Fatalf("%s: expected a PEM encoded CSR, got %q", requestType, csr)
+		}
+		req, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			t.Fatalf("%s: err: %s", requestType, err)
+		}
+		if req.Subject.CommonName != "example.com" || strings.Join(req.DNSNames, ",") != "example.com,*.example.com" {
+			t.Errorf("%s: unexpected CSR subject %q and names %v", requestType, req.Subject.CommonName, req.DNSNames)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"strings"
 
@@ -22,6 +23,12 @@ func resourceCloudflarePageRule() *schema.Resource {
 		},
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:     schema.TypeString,
@@ -295,7 +302,8 @@ func suppressEquivalentURLs(k, old, new string, d *schema.ResourceData) bool {
 }
 
 func resourceCloudflarePageRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	zone := d.Get("zone").(string)
 
 	newPageRuleTargets := []cloudflare.PageRuleTarget{
@@ -369,13 +377,12 @@ func pageRuleActionsToMap(vs []cloudflare.PageRuleAction) map[string]interface{}
 }
 
 func resourceCloudflarePageRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	pageRule, err := client.PageRule(zoneID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid Page Rule identifier") || // api bug - this indicates non-existing resource
-			strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Page Rule %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -411,7 +418,8 @@ func resourceCloudflarePageRuleRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceCloudflarePageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	updatePageRule := cloudflare.PageRule{}
@@ -470,13 +478,14 @@ func resourceCloudflarePageRuleUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCloudflarePageRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 	zone := d.Get("zone").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Page Rule: %s, %s", zone, d.Id())
 
-	if err := client.DeletePageRule(zoneID, d.Id()); err != nil {
+	if err := client.DeletePageRule(zoneID, d.Id()); err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error deleting Cloudflare Page Rule: %s", err)
 	}
 
@@ -609,7 +618,7 @@ func transformToCloudflarePageRuleAction(id string, value interface{}) (pageRule
 }
 
 func resourceCloudflarePageRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
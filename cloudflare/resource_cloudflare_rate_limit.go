@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -22,6 +23,12 @@ func resourceCloudflareRateLimit() *schema.Resource {
 		},
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:     schema.TypeString,
@@ -196,7 +203,8 @@ func resourceCloudflareRateLimit() *schema.Resource {
 }
 
 func resourceCloudflareRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	rateLimitAction, err := expandRateLimitAction(d)
 	if err != nil {
@@ -263,7 +271,8 @@ func resourceCloudflareRateLimitCreate(d *schema.ResourceData, meta interface{})
 
 func resourceCloudflareRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
 	// since api only supports replace, update looks a lot like create...
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneId := d.Get("zone_id").(string)
 	rateLimitId := d.Id()
 
@@ -434,13 +443,13 @@ func expandRateLimitBypass(bypassUrlPatterns *schema.Set) []cloudflare.RateLimit
 }
 
 func resourceCloudflareRateLimitRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneId := d.Get("zone_id").(string)
 	rateLimitId := d.Id()
 
 	rateLimit, err := client.RateLimit(zoneId, rateLimitId)
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Resource %s in zone %s no longer exists", rateLimitId, zoneId)
 			d.SetId("")
 			return nil
@@ -544,14 +553,15 @@ func flattenRateLimitCorrelate(cfg *cloudflare.RateLimitCorrelate) []map[string]
 }
 
 func resourceCloudflareRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneId := d.Get("zone_id").(string)
 	rateLimitId := d.Id()
 
 	log.Printf("[INFO] Deleting Cloudflare Rate Limit: %s for zone: %s", rateLimitId, zoneId)
 
 	err := client.DeleteRateLimit(zoneId, rateLimitId)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("error deleting Cloudflare Rate Limit for zone: %s", err)
 	}
 
@@ -559,7 +569,7 @@ func resourceCloudflareRateLimitDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCloudflareRateLimitImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
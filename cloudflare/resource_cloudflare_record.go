@@ -1,8 +1,11 @@
 package cloudflare
 
 import (
+	"bytes"
 	"fmt"
 	"log"
+	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -21,8 +24,16 @@ func resourceCloudflareRecord() *schema.Resource {
 			State: resourceCloudflareRecordImport,
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		MigrateState:  resourceCloudflareRecordMigrateState,
+		CustomizeDiff: resourceCloudflareRecordCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:     schema.TypeString,
@@ -33,7 +44,6 @@ func resourceCloudflareRecord() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				StateFunc: func(i interface{}) string {
 					return strings.ToLower(i.(string))
 				},
@@ -48,188 +58,27 @@ func resourceCloudflareRecord() *schema.Resource {
 			"type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"value": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{"data"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    recordDataBlocks(""),
+				DiffSuppressFunc: suppressRecordValueDiff,
 			},
 
-			"data": {
-				Type:          schema.TypeMap,
-				Optional:      true,
-				ConflictsWith: []string{"value"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						// Properties present in several record types
-						"algorithm": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"key_tag": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"flags": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"service": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"certificate": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"type": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"usage": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"selector": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"matching_type": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"weight": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-
-						// SRV record properties
-						"proto": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"name": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"priority": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"port": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"target": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						// LOC record properties
-						"size": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-						},
-						"altitude": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-						},
-						"long_degrees": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"lat_degrees": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"precision_horz": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-						},
-						"precision_vert": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-						},
-						"long_direction": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"long_minutes": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"long_seconds": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-						},
-						"lat_direction": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"lat_minutes": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"lat_seconds": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-						},
-
-						// DNSKEY record properties
-						"protocol": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"public_key": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						// DS record properties
-						"digest_type": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"digest": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						// NAPTR record properties
-						"order": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"preference": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"regex": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"replacement": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						// SSHFP record properties
-						"fingerprint": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						// URI record properties
-						"content": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-					},
-				},
-			},
+			"caa":    resourceCloudflareRecordDataSchema("CAA"),
+			"cert":   resourceCloudflareRecordDataSchema("CERT"),
+			"dnskey": resourceCloudflareRecordDataSchema("DNSKEY"),
+			"ds":     resourceCloudflareRecordDataSchema("DS"),
+			"loc":    resourceCloudflareRecordDataSchema("LOC"),
+			"naptr":  resourceCloudflareRecordDataSchema("NAPTR"),
+			"smimea": resourceCloudflareRecordDataSchema("SMIMEA"),
+			"srv":    resourceCloudflareRecordDataSchema("SRV"),
+			"sshfp":  resourceCloudflareRecordDataSchema("SSHFP"),
+			"tlsa":   resourceCloudflareRecordDataSchema("TLSA"),
+			"uri":    resourceCloudflareRecordDataSchema("URI"),
 
 			"ttl": {
 				Type:     schema.TypeInt,
@@ -273,12 +122,19 @@ func resourceCloudflareRecord() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"allow_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
 func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	newRecord := cloudflare.DNSRecord{
 		Type:     d.Get("type").(string),
@@ -289,31 +145,18 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 
 	value, valueOk := d.GetOk("value")
 	if valueOk {
-		newRecord.Content = value.(string)
+		newRecord.Content = apiRecordValue(newRecord.Type, value.(string))
 	}
 
-	data, dataOk := d.GetOk("data")
-	log.Printf("[DEBUG] Data found in config: %#v", data)
-
-	newDataMap := make(map[string]interface{})
-
+	data := expandCloudflareRecordData(d, newRecord.Type)
+	dataOk := data != nil
 	if dataOk {
-		for id, value := range data.(map[string]interface{}) {
-			newData, err := transformToCloudflareDNSData(newRecord.Type, id, value)
-			if err != nil {
-				return err
-			} else if newData == nil {
-				continue
-			}
-			newDataMap[id] = newData
-		}
-
-		newRecord.Data = newDataMap
+		newRecord.Data = data
 	}
 
 	if valueOk == dataOk {
 		return fmt.Errorf(
-			"either 'value' (present: %t) or 'data' (present: %t) must be provided",
+			"either 'value' (present: %t) or a data block (present: %t) must be provided",
 			valueOk, dataOk)
 	}
 
@@ -350,6 +193,10 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[DEBUG] Cloudflare Record create configuration: %#v", newRecord)
 
 	r, err := client.CreateDNSRecord(zoneID, newRecord)
+	if err != nil && isConflict(err) && d.Get("allow_overwrite").(bool) {
+		log.Printf("[DEBUG] Cloudflare Record %s already exists, adopting it: %s", newRecord.Name, err)
+		return resourceCloudflareRecordAdopt(d, meta, client, newRecord)
+	}
 	if err != nil {
 		return fmt.Errorf("Failed to create record: %s", err)
 	}
@@ -367,14 +214,49 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 	return resourceCloudflareRecordRead(d, meta)
 }
 
+// resourceCloudflareRecordAdopt takes over the existing record of the same
+// name and type that kept newRecord from being created, preferring one with
+// the same content, and updates it to the configuration. client is the one
+// of the create, so that adopting the record counts against its timeout.
+func resourceCloudflareRecordAdopt(d *schema.ResourceData, meta interface{}, client *providerClient, newRecord cloudflare.DNSRecord) error {
+	records, err := client.DNSRecords(newRecord.ZoneID, cloudflare.DNSRecord{
+		Name: recordFQDN(newRecord.Name, newRecord.ZoneName),
+		Type: newRecord.Type,
+	})
+	if err != nil {
+		return fmt.Errorf("Error finding the existing %s record %s: %s", newRecord.Type, newRecord.Name, err)
+	}
+
+	var existing *cloudflare.DNSRecord
+	for i, record := range records {
+		if canonicalRecordValue(record.Type, record.Content) == canonicalRecordValue(newRecord.Type, newRecord.Content) || len(records) == 1 {
+			existing = &records[i]
+			break
+		}
+	}
+	if existing == nil {
+		return fmt.Errorf("Failed to create record: found %d existing %s records %s to overwrite, but none with the same value", len(records), newRecord.Type, newRecord.Name)
+	}
+
+	log.Printf("[INFO] Adopting existing Cloudflare Record %s", existing.ID)
+
+	d.SetId(existing.ID)
+	if err := client.UpdateDNSRecord(newRecord.ZoneID, existing.ID, newRecord); err != nil {
+		return fmt.Errorf("Failed to update adopted Cloudflare Record %s: %s", existing.ID, err)
+	}
+
+	log.Printf("[INFO] Cloudflare Record ID: %s", d.Id())
+
+	return resourceCloudflareRecordRead(d, meta)
+}
+
 func resourceCloudflareRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	record, err := client.DNSRecord(zoneID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid dns record identifier") ||
-			strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[WARN] Removing record from state because it's not found in API")
 			d.SetId("")
 			return nil
@@ -382,74 +264,47 @@ func resourceCloudflareRecordRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	data, dataOk := d.GetOk("data")
-	log.Printf("[DEBUG] Data found in config: %#v", data)
-
-	readDataMap := make(map[string]interface{})
-
-	if dataOk {
-		for id, value := range data.(map[string]interface{}) {
-			newData, err := transformToCloudflareDNSData(record.Type, id, value)
-			if err != nil {
-				return err
-			} else if newData == nil {
-				continue
-			}
-			readDataMap[id] = newData
-		}
-
-		record.Data = readDataMap
-	}
-
 	d.SetId(record.ID)
 	d.Set("hostname", record.Name)
 	d.Set("type", record.Type)
-	d.Set("value", record.Content)
+	d.Set("value", canonicalRecordValue(record.Type, record.Content))
 	d.Set("ttl", record.TTL)
 	d.Set("priority", record.Priority)
 	d.Set("proxied", record.Proxied)
 	d.Set("created_on", record.CreatedOn.Format(time.RFC3339Nano))
-	d.Set("data", expandStringMap(record.Data))
+	if _, ok := recordDataFields[record.Type]; ok {
+		if err := d.Set(strings.ToLower(record.Type), flattenCloudflareRecordData(record.Type, record.Data)); err != nil {
+			return fmt.Errorf("Error setting %s data of record %q: %s", record.Type, d.Id(), err)
+		}
+	}
 	d.Set("modified_on", record.ModifiedOn.Format(time.RFC3339Nano))
 	if err := d.Set("metadata", expandStringMap(record.Meta)); err != nil {
 		log.Printf("[WARN] Error setting metadata: %s", err)
 	}
 	d.Set("proxiable", record.Proxiable)
+	// fill in the default for imported records and those created before it
+	d.Set("allow_overwrite", d.Get("allow_overwrite").(bool))
 
 	return nil
 }
 
 func resourceCloudflareRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	updateRecord := cloudflare.DNSRecord{
 		ID:       d.Id(),
 		Type:     d.Get("type").(string),
 		Name:     d.Get("name").(string),
-		Content:  d.Get("value").(string),
+		Content:  apiRecordValue(d.Get("type").(string), d.Get("value").(string)),
 		ZoneName: d.Get("domain").(string),
 		ZoneID:   zoneID,
 		Proxied:  false,
 	}
 
-	data, dataOk := d.GetOk("data")
-	log.Printf("[DEBUG] Data found in config: %#v", data)
-
-	newDataMap := make(map[string]interface{})
-
-	if dataOk {
-		for id, value := range data.(map[string]interface{}) {
-			newData, err := transformToCloudflareDNSData(updateRecord.Type, id, value)
-			if err != nil {
-				return err
-			} else if newData == nil {
-				continue
-			}
-			newDataMap[id] = newData
-		}
-
-		updateRecord.Data = newDataMap
+	if data := expandCloudflareRecordData(d, updateRecord.Type); data != nil {
+		updateRecord.Data = data
 	}
 
 	if priority, ok := d.GetOk("priority"); ok {
@@ -468,8 +323,21 @@ func resourceCloudflareRecordUpdate(d *schema.ResourceData, meta interface{}) er
 		updateRecord.TTL = ttl.(int)
 	}
 
+	if oldType, _ := d.GetChange("type"); !recordTypesCompatible(oldType.(string), updateRecord.Type) {
+		if err := replaceCloudflareRecord(d, client, updateRecord); err != nil {
+			return err
+		}
+		return resourceCloudflareRecordRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Cloudflare Record update configuration: %#v", updateRecord)
-	err := client.UpdateDNSRecord(zoneID, d.Id(), updateRecord)
+	var err error
+	if d.HasChange("type") {
+		// the SDK keeps the type of the record it updates
+		_, err = client.Raw("PUT", "/zones/"+zoneID+"/dns_records/"+d.Id(), updateRecord)
+	} else {
+		err = client.UpdateDNSRecord(zoneID, d.Id(), updateRecord)
+	}
 	if err != nil {
 		return fmt.Errorf("Failed to update Cloudflare Record: %s", err)
 	}
@@ -477,20 +345,186 @@ func resourceCloudflareRecordUpdate(d *schema.ResourceData, meta interface{}) er
 	return resourceCloudflareRecordRead(d, meta)
 }
 
+// replaceCloudflareRecord replaces a record whose type can't be changed in
+// place. The new record is created before the old one is deleted so that the
+// name keeps resolving, unless the API rejects the two records coexisting,
+// e.g. when one of them is a CNAME, in which case the old one goes first.
+func replaceCloudflareRecord(d *schema.ResourceData, client *providerClient, record cloudflare.DNSRecord) error {
+	oldID := d.Id()
+	record.ID = ""
+	if record.Data != nil {
+		record.Content = ""
+	}
+
+	log.Printf("[INFO] Replacing Cloudflare Record %s with a %s record", oldID, record.Type)
+
+	r, err := client.CreateDNSRecord(record.ZoneID, record)
+	if err != nil && isConflict(err) {
+		log.Printf("[DEBUG] Deleting Cloudflare Record %s before creating its replacement: %s", oldID, err)
+		if err := client.DeleteDNSRecord(record.ZoneID, oldID); err != nil && !isNotFound(err) {
+			return fmt.Errorf("Error deleting Cloudflare Record %s to replace it: %s", oldID, err)
+		}
+		oldID = ""
+		r, err = client.CreateDNSRecord(record.ZoneID, record)
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to create record replacing %s: %s", d.Id(), err)
+	}
+	if r.Result.ID == "" {
+		return fmt.Errorf("Failed to find record in Create response; Record was empty")
+	}
+
+	d.SetId(r.Result.ID)
+	log.Printf("[INFO] Cloudflare Record ID: %s", d.Id())
+
+	if oldID != "" {
+		if err := client.DeleteDNSRecord(record.ZoneID, oldID); err != nil && !isNotFound(err) {
+			return fmt.Errorf("Error deleting replaced Cloudflare Record %s: %s", oldID, err)
+		}
+	}
+
+	return nil
+}
+
+// recordTypeGroups are the groups of record types that a record can be changed
+// between in place, as they are all set by value alone.
+var recordTypeGroups = [][]string{
+	{"A", "AAAA", "CNAME"},
+	{"TXT", "SPF"},
+}
+
+func recordTypesCompatible(old, new string) bool {
+	if old == new {
+		return true
+	}
+	for _, group := range recordTypeGroups {
+		if contains(group, old) && contains(group, new) {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceCloudflareRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Record: %s, %s", zoneID, d.Id())
 
 	err := client.DeleteDNSRecord(zoneID, d.Id())
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error deleting Cloudflare Record: %s", err)
 	}
 
 	return nil
 }
 
+// resourceCloudflareRecordCustomizeDiff validates the value or data of a
+// record against its type at plan time, so that an invalid record fails the
+// plan rather than part way through an apply. Values that aren't known yet
+// are checked by the API instead.
+func resourceCloudflareRecordCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	recordType := d.Get("type").(string)
+	name := d.Get("name").(string)
+
+	// renames and compatible type changes are updated in place, other type
+	// changes replace the record in Update
+	if d.Id() != "" {
+		zoneName := d.Get("domain").(string)
+		oldName, newName := d.GetChange("name")
+		if d.NewValueKnown("name") && !strings.EqualFold(strings.TrimSuffix(oldName.(string), "."+zoneName), strings.TrimSuffix(newName.(string), "."+zoneName)) {
+			if err := d.SetNewComputed("hostname"); err != nil {
+				return err
+			}
+		}
+
+		if oldType, _ := d.GetChange("type"); !recordTypesCompatible(oldType.(string), recordType) {
+			log.Printf("[INFO] Record %s changes type from %s to %s, planning its replacement", d.Id(), oldType, recordType)
+			computed := []string{"created_on", "proxiable"}
+			if _, ok := recordDataFields[recordType]; ok {
+				computed = append(computed, "value")
+			}
+			for _, key := range computed {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	proxiedKnown := d.NewValueKnown("proxied")
+	proxied := d.Get("proxied").(bool)
+	if proxiedKnown {
+		if err := validateRecordType(recordType, proxied); err != nil {
+			return fmt.Errorf("Error validating record type %q: %s", recordType, err)
+		}
+	} else if err := validateRecordType(recordType, false); err != nil {
+		return fmt.Errorf("Error validating record type %q: %s", recordType, err)
+	}
+
+	if proxiedKnown && proxied && d.NewValueKnown("ttl") {
+		if ttl := d.Get("ttl").(int); ttl != 0 && ttl != 1 {
+			return fmt.Errorf("error validating record %s: ttl must be set to 1 when `proxied` is true", name)
+		}
+	}
+
+	for _, block := range recordDataBlocks(strings.ToLower(recordType)) {
+		if d.Get(block+".#").(int) > 0 {
+			return fmt.Errorf("Error validating record %s: the %q block is not valid for %s records", name, block, recordType)
+		}
+	}
+
+	if fields, ok := recordDataFields[recordType]; ok {
+		block := strings.ToLower(recordType)
+		if d.NewValueKnown(block) && d.Get(block+".#").(int) == 0 {
+			return fmt.Errorf("Error validating record %s: %s records must be set with the %q block", name, recordType, block)
+		}
+
+		data := make(map[string]interface{})
+		for _, field := range fields {
+			key := block + ".0." + field.key
+			if d.NewValueKnown(key) {
+				data[field.key] = d.Get(key)
+			} else {
+				data[field.key] = nil
+			}
+		}
+		if err := validateRecordData(recordType, data); err != nil {
+			return fmt.Errorf("Error validating record %s: %s", name, err)
+		}
+	} else if d.NewValueKnown("value") {
+		value := d.Get("value").(string)
+		if value == "" {
+			return fmt.Errorf("Error validating record %s: %s records require 'value'", name, recordType)
+		}
+		if err := validateRecordName(recordType, value); err != nil {
+			return fmt.Errorf("Error validating record name %q: %s", name, err)
+		}
+
+		// the value is canonicalized here rather than with a StateFunc, which
+		// only sees the value and not the type of the record
+		if canonical := canonicalRecordValue(recordType, value); canonical != value {
+			if oldValue, _ := d.GetChange("value"); oldValue.(string) != canonical {
+				if err := d.SetNew("value", canonical); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if (recordType == "MX" || recordType == "URI") && d.NewValueKnown("priority") {
+		if priority := d.Get("priority").(int); priority < 0 || priority > 65535 {
+			return fmt.Errorf("Error validating record %s: priority must be between 0 and 65535, got %d", name, priority)
+		}
+	}
+
+	return nil
+}
+
 func expandStringMap(inVal interface{}) map[string]string {
 	// although interface could hold anything
 	// we assume that it is either nil or a map of interface values
@@ -506,7 +540,7 @@ func expandStringMap(inVal interface{}) map[string]string {
 }
 
 func resourceCloudflareRecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -526,6 +560,9 @@ func resourceCloudflareRecordImport(d *schema.ResourceData, meta interface{}) ([
 
 	record, err := client.DNSRecord(zoneId, recordId)
 	if err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("record %q does not exist in zone %q", recordId, zoneName)
+		}
 		return nil, fmt.Errorf("Unable to find record with ID %q: %q", d.Id(), err)
 	}
 
@@ -540,55 +577,189 @@ func resourceCloudflareRecordImport(d *schema.ResourceData, meta interface{}) ([
 	return []*schema.ResourceData{d}, nil
 }
 
-var dnsTypeIntFields = []string{
-	"algorithm",
-	"key_tag",
-	"type",
-	"usage",
-	"selector",
-	"matching_type",
-	"weight",
-	"priority",
-	"port",
-	"long_degrees",
-	"lat_degrees",
-	"long_minutes",
-	"lat_minutes",
-	"protocol",
-	"digest_type",
-	"order",
-	"preference",
+// recordDataField describes a key of the data block of a record type, with
+// the function checking its value if it has one.
+type recordDataField struct {
+	key          string
+	kind         schema.ValueType
+	required     bool
+	defaultValue interface{}
+	validate     func(string) error
 }
 
-var dnsTypeFloatFields = []string{
-	"size",
-	"altitude",
-	"precision_horz",
-	"precision_vert",
-	"long_seconds",
-	"lat_seconds",
+// recordDataFields are the keys of the data of the record types that are set
+// with a data block rather than value, with the ranges the API accepts. The
+// block of a type is named after it in lower case.
+var recordDataFields = map[string][]recordDataField{
+	"CAA": {
+		{"flags", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"tag", schema.TypeString, true, nil, recordDataOneOf("issue", "issuewild", "iodef")},
+		{"value", schema.TypeString, true, nil, nil},
+	},
+	"CERT": {
+		{"type", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"key_tag", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"algorithm", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"certificate", schema.TypeString, true, nil, nil},
+	},
+	"DNSKEY": {
+		{"flags", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"protocol", schema.TypeInt, true, nil, recordDataInt(3, 3)},
+		{"algorithm", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"public_key", schema.TypeString, true, nil, nil},
+	},
+	"DS": {
+		{"key_tag", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"algorithm", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"digest_type", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"digest", schema.TypeString, true, nil, recordDataHex},
+	},
+	"LOC": {
+		{"lat_degrees", schema.TypeInt, true, nil, recordDataInt(0, 90)},
+		{"lat_minutes", schema.TypeInt, false, nil, recordDataInt(0, 59)},
+		{"lat_seconds", schema.TypeFloat, false, nil, recordDataFloat(0, 59.999)},
+		{"lat_direction", schema.TypeString, true, nil, recordDataOneOf("N", "S")},
+		{"long_degrees", schema.TypeInt, true, nil, recordDataInt(0, 180)},
+		{"long_minutes", schema.TypeInt, false, nil, recordDataInt(0, 59)},
+		{"long_seconds", schema.TypeFloat, false, nil, recordDataFloat(0, 59.999)},
+		{"long_direction", schema.TypeString, true, nil, recordDataOneOf("E", "W")},
+		{"altitude", schema.TypeFloat, true, nil, recordDataFloat(-100000, 42849672.95)},
+		// the defaults of RFC 1876
+		{"size", schema.TypeFloat, false, 1.0, recordDataFloat(0, 90000000)},
+		{"precision_horz", schema.TypeFloat, false, 10000.0, recordDataFloat(0, 90000000)},
+		{"precision_vert", schema.TypeFloat, false, 10.0, recordDataFloat(0, 90000000)},
+	},
+	"NAPTR": {
+		{"order", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"preference", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"flags", schema.TypeString, false, nil, nil},
+		{"service", schema.TypeString, false, nil, nil},
+		{"regex", schema.TypeString, false, nil, nil},
+		{"replacement", schema.TypeString, true, nil, recordDataTarget},
+	},
+	"SMIMEA": {
+		{"usage", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"selector", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"matching_type", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"certificate", schema.TypeString, true, nil, recordDataHex},
+	},
+	"SRV": {
+		{"service", schema.TypeString, true, nil, recordDataServiceLabel},
+		{"proto", schema.TypeString, true, nil, recordDataServiceLabel},
+		{"name", schema.TypeString, true, nil, validateRecordHostname},
+		{"priority", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"weight", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"port", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"target", schema.TypeString, true, nil, recordDataTarget},
+	},
+	"SSHFP": {
+		{"algorithm", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"type", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"fingerprint", schema.TypeString, true, nil, recordDataHex},
+	},
+	"TLSA": {
+		{"usage", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"selector", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"matching_type", schema.TypeInt, true, nil, recordDataInt(0, 255)},
+		{"certificate", schema.TypeString, true, nil, recordDataHex},
+	},
+	"URI": {
+		{"weight", schema.TypeInt, true, nil, recordDataInt(0, 65535)},
+		{"content", schema.TypeString, true, nil, nil},
+	},
 }
 
-func transformToCloudflareDNSData(recordType string, id string, value interface{}) (newValue interface{}, err error) {
-	switch {
-	case id == "flags":
-		switch {
-		case strings.ToUpper(recordType) == "SRV",
-			strings.ToUpper(recordType) == "CAA",
-			strings.ToUpper(recordType) == "DNSKEY":
-			newValue, err = strconv.Atoi(value.(string))
-		case strings.ToUpper(recordType) == "NAPTR":
-			newValue, err = value.(string), nil
+// recordDataHostnameFields are the data keys holding domain names, which the
+// API may return in a different case or without the trailing dot.
+var recordDataHostnameFields = []string{"name", "target", "replacement"}
+
+// resourceCloudflareRecordDataSchema is the schema of the block holding the
+// data of records of recordType.
+func resourceCloudflareRecordDataSchema(recordType string) *schema.Schema {
+	fields := make(map[string]*schema.Schema)
+	for _, field := range recordDataFields[recordType] {
+		s := &schema.Schema{
+			Type:     field.kind,
+			Required: field.required,
+			Optional: !field.required,
+			Default:  field.defaultValue,
+		}
+		if contains(recordDataHostnameFields, field.key) {
+			s.DiffSuppressFunc = suppressRecordHostnameDiff
+		}
+		fields[field.key] = s
+	}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: append(recordDataBlocks(strings.ToLower(recordType)), "value"),
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+// recordDataBlocks returns the sorted names of the data blocks, leaving out
+// except.
+func recordDataBlocks(except string) []string {
+	blocks := make([]string, 0, len(recordDataFields))
+	for recordType := range recordDataFields {
+		if block := strings.ToLower(recordType); block != except {
+			blocks = append(blocks, block)
+		}
+	}
+	sort.Strings(blocks)
+	return blocks
+}
+
+// expandCloudflareRecordData returns the data of the block of recordType, or
+// nil if the type has no block or it isn't set.
+func expandCloudflareRecordData(d *schema.ResourceData, recordType string) map[string]interface{} {
+	if _, ok := recordDataFields[recordType]; !ok {
+		return nil
+	}
+
+	blocks := d.Get(strings.ToLower(recordType)).([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	log.Printf("[DEBUG] Data found in config: %#v", blocks[0])
+
+	return blocks[0].(map[string]interface{})
+}
+
+// flattenCloudflareRecordData converts the data of a record as returned by the
+// API, with numbers decoded from JSON, to the block of its type.
+func flattenCloudflareRecordData(recordType string, data interface{}) []interface{} {
+	values, ok := data.(map[string]interface{})
+	if !ok || len(values) == 0 {
+		return []interface{}{}
+	}
+
+	block := make(map[string]interface{})
+	for _, field := range recordDataFields[recordType] {
+		v, ok := values[field.key]
+		if !ok || v == nil {
+			continue
+		}
+
+		switch field.kind {
+		case schema.TypeInt:
+			if n, err := strconv.ParseFloat(fmt.Sprintf("%v", v), 64); err == nil {
+				block[field.key] = int(n)
+			}
+		case schema.TypeFloat:
+			if n, err := strconv.ParseFloat(fmt.Sprintf("%v", v), 64); err == nil {
+				block[field.key] = n
+			}
+		default:
+			block[field.key] = fmt.Sprintf("%v", v)
 		}
-	case contains(dnsTypeIntFields, id):
-		newValue, err = strconv.Atoi(value.(string))
-	case contains(dnsTypeFloatFields, id):
-		newValue, err = strconv.ParseFloat(value.(string), 32)
-	default:
-		newValue, err = value.(string), nil
 	}
 
-	return
+	return []interface{}{block}
 }
 
 func suppressPriority(k, old, new string, d *schema.ResourceData) bool {
@@ -599,7 +770,88 @@ func suppressPriority(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
+// canonicalRecordValue returns value in the form the API returns it for
+// records of recordType, so that equivalent values compare equal: IP addresses
+// in their shortest form, hostnames in lower case without the trailing dot and
+// TXT values split into quoted strings joined back together.
+func canonicalRecordValue(recordType, value string) string {
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String()
+		}
+	case "CNAME", "MX", "NS":
+		if value == "." {
+			return value
+		}
+		return strings.ToLower(strings.TrimSuffix(value, "."))
+	case "TXT", "SPF":
+		return joinRecordTXTChunks(value)
+	}
+	return value
+}
+
+// joinRecordTXTChunks joins a TXT value given as quoted strings, which is how
+// the API returns values longer than 255 characters.
+func joinRecordTXTChunks(value string) string {
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return value
+	}
+
+	entries, err := scanZoneFile(value)
+	if err != nil || len(entries) != 1 {
+		return value
+	}
+	var b bytes.Buffer
+	for _, token := range entries[0].tokens {
+		if !token.quoted {
+			return value
+		}
+		b.WriteString(token.text)
+	}
+	return b.String()
+}
+
+// apiRecordValue returns the content sent to the API for the value of a
+// record, splitting TXT values too long for a single string into several
+// quoted strings.
+func apiRecordValue(recordType, value string) string {
+	if (recordType == "TXT" || recordType == "SPF") && len(value) > zoneFileMaxStringLength && joinRecordTXTChunks(value) == value {
+		return zoneFileQuote(value)
+	}
+	return value
+}
+
+func suppressRecordValueDiff(k, old, new string, d *schema.ResourceData) bool {
+	recordType := d.Get("type").(string)
+	// the API returns "@" as the name of the zone
+	if new == "@" && (recordType == "CNAME" || recordType == "MX") {
+		new = d.Get("domain").(string)
+	}
+	return canonicalRecordValue(recordType, old) == canonicalRecordValue(recordType, new)
+}
+
+// recordFQDN returns the fully qualified name of a record given relative to
+// its zone, fully qualified or as "@" for the apex of the zone.
+func recordFQDN(name, zoneName string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	zoneName = strings.ToLower(zoneName)
+	switch {
+	case name == "":
+		return ""
+	case name == "@":
+		return zoneName
+	case name == zoneName || strings.HasSuffix(name, "."+zoneName):
+		return name
+	}
+	return name + "." + zoneName
+}
+
 func suppressNameDiff(k, old, new string, d *schema.ResourceData) bool {
 	zoneName := d.Get("domain").(string)
 	return strings.TrimSuffix(old, "."+zoneName) == strings.TrimSuffix(new, "."+zoneName)
 }
+
+func suppressRecordHostnameDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(strings.TrimSuffix(old, "."), strings.TrimSuffix(new, "."))
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -14,7 +16,14 @@ func resourceCloudflareRecordMigrateState(
 	switch v {
 	case 0:
 		log.Println("[INFO] Found Cloudflare Record State v0; migrating to v1")
-		return migrateCloudflareRecordStateV0toV1(is, meta)
+		var err error
+		if is, err = migrateCloudflareRecordStateV0toV1(is, meta); err != nil {
+			return is, err
+		}
+		fallthrough
+	case 1:
+		log.Println("[INFO] Found Cloudflare Record State v1; migrating to v2")
+		return migrateCloudflareRecordStateV1toV2(is)
 	default:
 		return is, fmt.Errorf("Unexpected schema version: %d", v)
 	}
@@ -27,7 +36,7 @@ func migrateCloudflareRecordStateV0toV1(is *terraform.InstanceState, meta interf
 	}
 
 	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	// look up new id based on attributes
 	domain := is.Attributes["domain"]
@@ -93,3 +102,48 @@ func migrateCloudflareRecordStateV0toV1(is *terraform.InstanceState, meta interf
 	log.Printf("[DEBUG] Attributes after no migration: %#v", is.Attributes)
 	return is, fmt.Errorf("No matching Record found")
 }
+
+// migrateCloudflareRecordStateV1toV2 moves the data map, which held every
+// value as a string, to the typed block named after the record type.
+func migrateCloudflareRecordStateV1toV2(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	recordType := is.Attributes["type"]
+	block := strings.ToLower(recordType)
+	for _, field := range recordDataFields[recordType] {
+		v, ok := is.Attributes["data."+field.key]
+		if !ok {
+			continue
+		}
+
+		switch field.kind {
+		case schema.TypeInt, schema.TypeFloat:
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return is, fmt.Errorf("Error converting data.%s to a number in Cloudflare Record Migration", field.key)
+			}
+			if field.kind == schema.TypeInt {
+				v = strconv.Itoa(int(n))
+			} else {
+				v = strconv.FormatFloat(n, 'f', -1, 64)
+			}
+		}
+
+		is.Attributes[block+".#"] = "1"
+		is.Attributes[block+".0."+field.key] = v
+	}
+
+	for k := range is.Attributes {
+		if strings.HasPrefix(k, "data.") {
+			delete(is.Attributes, k)
+		}
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
@@ -1,11 +1,13 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -136,7 +138,7 @@ func TestCloudflareRecordMigrateState(t *testing.T) {
 			Attributes: tc.Attributes,
 		}
 		is, err := resourceCloudflareRecordMigrateState(
-			tc.StateVersion, is, cfMeta)
+			tc.StateVersion, is, newProviderClient(cfMeta, context.Background(), http.DefaultTransport))
 
 		if err != nil {
 			if tc.ShouldFail {
@@ -152,6 +154,102 @@ func TestCloudflareRecordMigrateState(t *testing.T) {
 	}
 }
 
+func TestCloudflareRecordMigrateStateV1toV2(t *testing.T) {
+	cases := map[string]struct {
+		Attributes map[string]string
+		Expected   map[string]string
+	}{
+		"srv": {
+			Attributes: map[string]string{
+				"type":          "SRV",
+				"value":         "0\t5222\ttalk.l.google.com",
+				"data.%":        "7",
+				"data.priority": "5",
+				"data.weight":   "0",
+				"data.port":     "5222",
+				"data.target":   "talk.l.google.com",
+				"data.service":  "_xmpp-client",
+				"data.proto":    "_tcp",
+				"data.name":     "hashicorptest.com",
+			},
+			Expected: map[string]string{
+				"type":           "SRV",
+				"value":          "0\t5222\ttalk.l.google.com",
+				"srv.#":          "1",
+				"srv.0.priority": "5",
+				"srv.0.weight":   "0",
+				"srv.0.port":     "5222",
+				"srv.0.target":   "talk.l.google.com",
+				"srv.0.service":  "_xmpp-client",
+				"srv.0.proto":    "_tcp",
+				"srv.0.name":     "hashicorptest.com",
+			},
+		},
+		"loc": {
+			Attributes: map[string]string{
+				"type":               "LOC",
+				"data.%":             "5",
+				"data.lat_degrees":   "37",
+				"data.lat_seconds":   "46.000",
+				"data.lat_direction": "N",
+				"data.altitude":      "0.00",
+				"data.size":          "100.5",
+			},
+			Expected: map[string]string{
+				"type":                "LOC",
+				"loc.#":               "1",
+				"loc.0.lat_degrees":   "37",
+				"loc.0.lat_seconds":   "46",
+				"loc.0.lat_direction": "N",
+				"loc.0.altitude":      "0",
+				"loc.0.size":          "100.5",
+			},
+		},
+		"caa": {
+			Attributes: map[string]string{
+				"type":       "CAA",
+				"data.%":     "3",
+				"data.flags": "0",
+				"data.tag":   "issue",
+				"data.value": "letsencrypt.org",
+			},
+			Expected: map[string]string{
+				"type":        "CAA",
+				"caa.#":       "1",
+				"caa.0.flags": "0",
+				"caa.0.tag":   "issue",
+				"caa.0.value": "letsencrypt.org",
+			},
+		},
+		"a": {
+			Attributes: map[string]string{
+				"type":   "A",
+				"value":  "10.0.2.5",
+				"data.%": "0",
+			},
+			Expected: map[string]string{
+				"type":  "A",
+				"value": "10.0.2.5",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "123456",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceCloudflareRecordMigrateState(1, is, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Fatalf("bad: %s\n\n expected: %#v\n\n got: %#v", tn, tc.Expected, is.Attributes)
+		}
+	}
+}
+
 // cloudflareEnv establishes a httptest server to mock out the Cloudflare API
 // endpoints that we'll be calling.
 func mockCloudflareEnv() *httptest.Server {
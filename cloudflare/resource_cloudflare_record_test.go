@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -36,8 +39,8 @@ func TestAccCloudflareRecord_Basic(t *testing.T) {
 						resourceName, "domain", domain),
 					resource.TestCheckResourceAttr(
 						resourceName, "value", "192.168.0.10"),
-					resource.TestCheckResourceAttr(
-						resourceName, "data.%", "0"),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "srv.#"),
 					resource.TestCheckResourceAttr(
 						resourceName, "hostname", fmt.Sprintf("tf-acctest-basic.%s", domain)),
 					resource.TestMatchResourceAttr(
@@ -116,6 +119,27 @@ func TestAccCloudflareRecord_Apex(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_NullMXAndApexTarget(t *testing.T) {
+	t.Parallel()
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigNullMXAndApexTarget(domain, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudflare_record.mx", "value", "."),
+					resource.TestCheckResourceAttr("cloudflare_record.cname", "value", domain),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRecord_LOC(t *testing.T) {
 	t.Parallel()
 	var record cloudflare.DNSRecord
@@ -136,7 +160,9 @@ func TestAccCloudflareRecord_LOC(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						resourceName, "proxiable", "false"),
 					resource.TestCheckResourceAttr(
-						resourceName, "data.%", "12"),
+						resourceName, "loc.#", "1"),
+					resource.TestCheckResourceAttr(
+						resourceName, "loc.0.lat_seconds", "46"),
 				),
 			},
 		},
@@ -165,7 +191,38 @@ func TestAccCloudflareRecord_SRV(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						resourceName, "proxiable", "false"),
 					resource.TestCheckResourceAttr(
-						resourceName, "data.%", "7"),
+						resourceName, "srv.#", "1"),
+					resource.TestCheckResourceAttr(
+						resourceName, "srv.0.port", "5222"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_CAA(t *testing.T) {
+	t.Parallel()
+	var record cloudflare.DNSRecord
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := fmt.Sprintf("cloudflare_record.foobar")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigCAA(domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(
+						resourceName, "value", `0 issue "letsencrypt.org"`),
+					resource.TestCheckResourceAttr(
+						resourceName, "caa.#", "1"),
+					resource.TestCheckResourceAttr(
+						resourceName, "caa.0.flags", "0"),
+					resource.TestCheckResourceAttr(
+						resourceName, "caa.0.tag", "issue"),
 				),
 			},
 		},
@@ -195,8 +252,8 @@ func TestAccCloudflareRecord_Proxied(t *testing.T) {
 						resourceName, "type", "CNAME"),
 					resource.TestCheckResourceAttr(
 						resourceName, "value", domain),
-					resource.TestCheckResourceAttr(
-						resourceName, "data.%", "0"),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "srv.#"),
 				),
 			},
 		},
@@ -233,11 +290,11 @@ func TestAccCloudflareRecord_Updated(t *testing.T) {
 	})
 }
 
-func TestAccCloudflareRecord_typeForceNewRecord(t *testing.T) {
+func TestAccCloudflareRecord_typeUpdatedInPlace(t *testing.T) {
 	t.Parallel()
 	var afterCreate, afterUpdate cloudflare.DNSRecord
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
-	recordName := "tf-acctest-type-force-new"
+	recordName := "tf-acctest-type-in-place"
 	resourceName := fmt.Sprintf("cloudflare_record.foobar")
 
 	resource.Test(t, resource.TestCase{
@@ -253,20 +310,62 @@ func TestAccCloudflareRecord_typeForceNewRecord(t *testing.T) {
 			},
 			{
 				Config: testAccCheckCloudflareRecordConfigChangeType(domain, recordName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &afterUpdate),
+					testAccCheckCloudflareRecordUpdatedInPlace(&afterCreate, &afterUpdate),
+					resource.TestCheckResourceAttr(resourceName, "type", "CNAME"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_typeReplaced(t *testing.T) {
+	t.Parallel()
+	var afterCreate, afterUpdate, afterReplace cloudflare.DNSRecord
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	recordName := "tf-acctest-type-replaced"
+	resourceName := fmt.Sprintf("cloudflare_record.foobar")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigBasic(domain, recordName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &afterCreate),
+				),
+			},
+			{
+				// the TXT record is created before the A record is deleted
+				Config: testAccCheckCloudflareRecordConfigTXT(domain, recordName, "v=spf1 -all"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareRecordExists(resourceName, &afterUpdate),
 					testAccCheckCloudflareRecordRecreated(&afterCreate, &afterUpdate),
+					testAccCheckCloudflareRecordDeleted(&afterCreate),
+				),
+			},
+			{
+				// a CNAME can't coexist with the TXT record, which is deleted first
+				Config: testAccCheckCloudflareRecordConfigChangeType(domain, recordName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &afterReplace),
+					testAccCheckCloudflareRecordRecreated(&afterUpdate, &afterReplace),
+					testAccCheckCloudflareRecordDeleted(&afterUpdate),
+					resource.TestCheckResourceAttr(resourceName, "type", "CNAME"),
 				),
 			},
 		},
 	})
 }
 
-func TestAccCloudflareRecord_hostnameForceNewRecord(t *testing.T) {
+func TestAccCloudflareRecord_hostnameUpdatedInPlace(t *testing.T) {
 	t.Parallel()
 	var afterCreate, afterUpdate cloudflare.DNSRecord
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
-	recordName := "tf-acctest-hostname-force-new"
+	recordName := "tf-acctest-hostname-in-place"
 	resourceName := fmt.Sprintf("cloudflare_record.foobar")
 
 	resource.Test(t, resource.TestCase{
@@ -284,7 +383,9 @@ func TestAccCloudflareRecord_hostnameForceNewRecord(t *testing.T) {
 				Config: testAccCheckCloudflareRecordConfigChangeHostname(domain, recordName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareRecordExists(resourceName, &afterUpdate),
-					testAccCheckCloudflareRecordRecreated(&afterCreate, &afterUpdate),
+					testAccCheckCloudflareRecordUpdatedInPlace(&afterCreate, &afterUpdate),
+					resource.TestCheckResourceAttr(
+						resourceName, "hostname", fmt.Sprintf("%s-changed.%s", recordName, domain)),
 				),
 			},
 		},
@@ -321,6 +422,48 @@ func TestAccCloudflareRecord_CreateAfterManualDestroy(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_AllowOverwrite(t *testing.T) {
+	t.Parallel()
+	var existing, adopted cloudflare.DNSRecord
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	recordName := "tf-acctest-allow-overwrite"
+	resourceName := "cloudflare_record.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				// a record created outside of Terraform
+				Config: fmt.Sprintf(`
+resource "cloudflare_record" "other" {
+	domain = "%s"
+	name = "%s-other"
+	value = "192.168.0.10"
+	type = "A"
+}`, domain, recordName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCreateRecord(domain, recordName, &existing),
+				),
+			},
+			{
+				Config:      testAccCheckCloudflareRecordConfigAllowOverwrite(domain, recordName, false),
+				ExpectError: regexp.MustCompile("Failed to create record"),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigAllowOverwrite(domain, recordName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &adopted),
+					testAccCheckCloudflareRecordUpdatedInPlace(&existing, &adopted),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "allow_overwrite", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRecord_TtlValidation(t *testing.T) {
 	t.Parallel()
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
@@ -364,6 +507,160 @@ func TestAccCloudflareRecord_TtlValidationUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_PlanValidation(t *testing.T) {
+	t.Parallel()
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareRecordConfigSRVPort(domain, 70000),
+				ExpectError: regexp.MustCompile("srv.port must be between 0 and 65535, got 70000"),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigInvalid(domain, `
+	type = "CAA"
+	caa {
+	  flags = 256
+	  tag = "issue"
+	  value = "letsencrypt.org"
+	}`),
+				ExpectError: regexp.MustCompile("caa.flags must be between 0 and 255, got 256"),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigInvalid(domain, `
+	type = "TXT"
+	srv {
+	  priority = 5
+	  weight = 0
+	  port = 5222
+	  target = "talk.l.google.com"
+	  service = "_xmpp-client"
+	  proto = "_tcp"
+	  name = "example.com"
+	}`),
+				ExpectError: regexp.MustCompile(`the "srv" block is not valid for TXT records`),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigInvalid(domain, `
+	type = "MX"
+	value = "mx..example.com"
+	priority = 10`),
+				ExpectError: regexp.MustCompile("MX record must be a valid hostname"),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigInvalid(domain, `
+	type = "TXT"
+	value = "v=spf1 -all"
+	proxied = true`),
+				ExpectError: regexp.MustCompile(`Type "TXT" cannot be proxied`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_Normalized(t *testing.T) {
+	t.Parallel()
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := acctest.RandString(10)
+	dkim := "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A", 12)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigNormalized(domain, rnd, dkim),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudflare_record.aaaa", "value", "2001:db8::1"),
+					resource.TestCheckResourceAttr("cloudflare_record.cname", "value", "target.example.com"),
+					resource.TestCheckResourceAttr("cloudflare_record.txt", "value", dkim),
+					testAccCheckCloudflareRecordContent("cloudflare_record.txt", `"`+dkim[:255]+`" "`+dkim[255:]+`"`),
+				),
+			},
+		},
+	})
+}
+
+func TestCanonicalRecordValue(t *testing.T) {
+	cases := []struct {
+		recordType, value, expected string
+	}{
+		{"AAAA", "2001:0DB8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		{"A", "192.0.2.1", "192.0.2.1"},
+		{"CNAME", "Target.Example.COM.", "target.example.com"},
+		{"MX", "mx.example.com.", "mx.example.com"},
+		{"MX", ".", "."},
+		{"TXT", `"first part, " "second part with a \"quote\""`, `first part, second part with a "quote"`},
+		{"TXT", `"quoted" within`, `"quoted" within`},
+		{"TXT", "Mixed Case", "Mixed Case"},
+		{"SRV", "0\t5222\tTalk.L.Google.com", "0\t5222\tTalk.L.Google.com"},
+	}
+	for _, c := range cases {
+		if v := canonicalRecordValue(c.recordType, c.value); v != c.expected {
+			t.Errorf("%s %q: expected %q, got %q", c.recordType, c.value, c.expected, v)
+		}
+	}
+
+	long := strings.Repeat("a", 300)
+	quoted := apiRecordValue("TXT", long)
+	if quoted != `"`+strings.Repeat("a", 255)+`" "`+strings.Repeat("a", 45)+`"` {
+		t.Errorf("expected the TXT value to be split into quoted strings, got %q", quoted)
+	}
+	if v := canonicalRecordValue("TXT", quoted); v != long {
+		t.Errorf("expected the quoted strings to join back, got %q", v)
+	}
+	if v := apiRecordValue("TXT", quoted); v != quoted {
+		t.Errorf("expected quoted strings to be sent as is, got %q", v)
+	}
+}
+
+func TestResourceCloudflareRecordDiff_CanonicalValue(t *testing.T) {
+	cases := []struct {
+		recordType, value, expected string
+	}{
+		{"CNAME", "Target.Example.COM.", "target.example.com"},
+		{"AAAA", "2001:0DB8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		{"A", "192.0.2.1", "192.0.2.1"},
+	}
+	for _, c := range cases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"domain": "example.com",
+			"name":   "test",
+			"type":   c.recordType,
+			"value":  c.value,
+		})
+		if err != nil {
+			t.Fatalf("%s: err: %s", c.recordType, err)
+		}
+
+		diff, err := resourceCloudflareRecord().Diff(nil, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("%s: err: %s", c.recordType, err)
+		}
+		if attr := diff.Attributes["value"]; attr == nil || attr.New != c.expected {
+			t.Errorf("%s %q: expected the planned value to be %q, got %#v", c.recordType, c.value, c.expected, attr)
+		}
+	}
+}
+
+func testAccCheckCloudflareRecordContent(n, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var record cloudflare.DNSRecord
+		if err := testAccCheckCloudflareRecordExists(n, &record)(s); err != nil {
+			return err
+		}
+		if record.Content != content {
+			return fmt.Errorf("expected the content of %s to be %q, got %q", n, content, record.Content)
+		}
+		return nil
+	}
+}
+
 func testAccCheckCloudflareRecordRecreated(before, after *cloudflare.DNSRecord) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if before.ID == after.ID {
@@ -373,8 +670,52 @@ func testAccCheckCloudflareRecordRecreated(before, after *cloudflare.DNSRecord)
 	}
 }
 
+func testAccCheckCloudflareRecordUpdatedInPlace(before, after *cloudflare.DNSRecord) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("Expected the record to be updated in place, but its ID changed from %v to %v", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCloudflareRecordDeleted(record *cloudflare.DNSRecord) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerClient)
+		if _, err := client.DNSRecord(record.ZoneID, record.ID); err == nil {
+			return fmt.Errorf("Expected record %s to be deleted", record.ID)
+		}
+		return nil
+	}
+}
+
+// testAccCreateRecord creates an A record through the API, as if it was
+// created outside of Terraform.
+func testAccCreateRecord(zone, name string, record *cloudflare.DNSRecord) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerClient)
+		zoneID, err := client.ZoneIDByName(zone)
+		if err != nil {
+			return err
+		}
+
+		r, err := client.CreateDNSRecord(zoneID, cloudflare.DNSRecord{
+			Type:    "A",
+			Name:    name,
+			Content: "192.168.0.10",
+			TTL:     3600,
+		})
+		if err != nil {
+			return err
+		}
+
+		*record = r.Result
+		return nil
+	}
+}
+
 func testAccCheckCloudflareRecordDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*providerClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_record" {
@@ -392,7 +733,7 @@ func testAccCheckCloudflareRecordDestroy(s *terraform.State) error {
 
 func testAccManuallyDeleteRecord(record *cloudflare.DNSRecord) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		err := client.DeleteDNSRecord(record.ZoneID, record.ID)
 		if err != nil {
 			return err
@@ -462,7 +803,7 @@ func testAccCheckCloudflareRecordExists(n string, record *cloudflare.DNSRecord)
 			return fmt.Errorf("No Record ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundRecord, err := client.DNSRecord(rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
 			return err
@@ -501,24 +842,42 @@ resource "cloudflare_record" "foobar" {
 }`, zone)
 }
 
+func testAccCheckCloudflareRecordConfigNullMXAndApexTarget(zone, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "mx" {
+	domain = "%[1]s"
+	name = "tf-acctest-mx-%[2]s"
+	value = "."
+	type = "MX"
+	priority = 0
+}
+
+resource "cloudflare_record" "cname" {
+	domain = "%[1]s"
+	name = "tf-acctest-cname-%[2]s"
+	value = "@"
+	type = "CNAME"
+}`, zone, rnd)
+}
+
 func testAccCheckCloudflareRecordConfigLOC(zone string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "foobar" {
 	domain = "%[1]s"
 	name = "%[1]s"
-	data {
-	  "lat_degrees" =  "37"
-	  "lat_minutes" = "46"
-	  "lat_seconds" = 46.000
-	  "lat_direction" = "N"
-	  "long_degrees" = "122"
-	  "long_minutes" = "23"
-	  "long_seconds" = 35.000
-	  "long_direction" = "W"
-	  "altitude" = 0.00
-	  "size" = 100.00
-	  "precision_horz" = 0.00
-	  "precision_vert" = 0.00
+	loc {
+	  lat_degrees = 37
+	  lat_minutes = 46
+	  lat_seconds = 46.000
+	  lat_direction = "N"
+	  long_degrees = 122
+	  long_minutes = 23
+	  long_seconds = 35.000
+	  long_direction = "W"
+	  altitude = 0.00
+	  size = 100.00
+	  precision_horz = 0.00
+	  precision_vert = 0.00
 	}
 	type = "LOC"
 	ttl = 3600
@@ -530,20 +889,35 @@ func testAccCheckCloudflareRecordConfigSRV(zone string) string {
 resource "cloudflare_record" "foobar" {
 	domain = "%[1]s"
 	name = "%[1]s"
-	data {
-	  "priority" = 5
-      "weight" = 0
-      "port" = 5222
-      "target" = "talk.l.google.com"
-      "service" = "_xmpp-client"
-      "proto" = "_tcp"
-      "name" = "%[1]s"
+	srv {
+	  priority = 5
+	  weight = 0
+	  port = 5222
+	  target = "talk.l.google.com"
+	  service = "_xmpp-client"
+	  proto = "_tcp"
+	  name = "%[1]s"
 	}
 	type = "SRV"
 	ttl = 3600
 }`, zone)
 }
 
+func testAccCheckCloudflareRecordConfigCAA(zone string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "foobar" {
+	domain = "%[1]s"
+	name = "%[1]s"
+	caa {
+	  flags = 0
+	  tag = "issue"
+	  value = "letsencrypt.org"
+	}
+	type = "CAA"
+	ttl = 3600
+}`, zone)
+}
+
 func testAccCheckCloudflareRecordConfigProxied(zone, name string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "foobar" {
@@ -580,6 +954,18 @@ resource "cloudflare_record" "foobar" {
 }`, zone, name)
 }
 
+func testAccCheckCloudflareRecordConfigTXT(zone, name, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "foobar" {
+	domain = "%s"
+
+	name = "%s"
+	value = "%s"
+	type = "TXT"
+	ttl = 3600
+}`, zone, name, value)
+}
+
 func testAccCheckCloudflareRecordConfigChangeHostname(zone, name string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "foobar" {
@@ -604,3 +990,67 @@ resource "cloudflare_record" "foobar" {
 	ttl = 3600
 }`, zone, name)
 }
+
+func testAccCheckCloudflareRecordConfigSRVPort(zone string, port int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "foobar" {
+	domain = "%[1]s"
+	name = "_xmpp-client._tcp"
+	srv {
+	  priority = 5
+	  weight = 0
+	  port = %[2]d
+	  target = "talk.l.google.com"
+	  service = "_xmpp-client"
+	  proto = "_tcp"
+	  name = "%[1]s"
+	}
+	type = "SRV"
+}`, zone, port)
+}
+
+func testAccCheckCloudflareRecordConfigInvalid(zone, attributes string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "foobar" {
+	domain = "%[1]s"
+	name = "tf-acctest-invalid"
+%[2]s
+}`, zone, attributes)
+}
+
+func testAccCheckCloudflareRecordConfigAllowOverwrite(zone, name string, allowOverwrite bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "foobar" {
+	domain = "%[1]s"
+
+	name = "%[2]s"
+	value = "192.168.0.10"
+	type = "A"
+	ttl = 300
+	allow_overwrite = %[3]t
+}`, zone, name, allowOverwrite)
+}
+
+func testAccCheckCloudflareRecordConfigNormalized(zone, rnd, txt string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "aaaa" {
+	domain = "%[1]s"
+	name = "tf-acctest-aaaa-%[2]s"
+	value = "2001:0DB8:0000:0000:0000:0000:0000:0001"
+	type = "AAAA"
+}
+
+resource "cloudflare_record" "cname" {
+	domain = "%[1]s"
+	name = "tf-acctest-cname-%[2]s"
+	value = "Target.Example.COM."
+	type = "CNAME"
+}
+
+resource "cloudflare_record" "txt" {
+	domain = "%[1]s"
+	name = "tf-acctest-txt-%[2]s"
+	value = "%[3]s"
+	type = "TXT"
+}`, zone, rnd, txt)
+}
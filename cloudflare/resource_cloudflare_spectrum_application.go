@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -22,6 +23,12 @@ func resourceCloudflareSpectrumApplication() *schema.Resource {
 		},
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -101,7 +108,8 @@ func resourceCloudflareSpectrumApplication() *schema.Resource {
 }
 
 func resourceCloudflareSpectrumApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	newSpectrumApp := applicationFromResource(d)
 	zoneID := d.Get("zone_id").(string)
@@ -125,7 +133,8 @@ func resourceCloudflareSpectrumApplicationCreate(d *schema.ResourceData, meta in
 }
 
 func resourceCloudflareSpectrumApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	application := applicationFromResource(d)
@@ -141,13 +150,13 @@ func resourceCloudflareSpectrumApplicationUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceCloudflareSpectrumApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	applicationID := d.Id()
 
 	application, err := client.SpectrumApplication(zoneID, applicationID)
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Spectrum application %s in zone %s not found", applicationID, zoneID)
 			d.SetId("")
 			return nil
@@ -183,14 +192,15 @@ func resourceCloudflareSpectrumApplicationRead(d *schema.ResourceData, meta inte
 }
 
 func resourceCloudflareSpectrumApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 	applicationID := d.Id()
 
 	log.Printf("[INFO] Deleting Cloudflare Spectrum Application: %s in zone: %s", applicationID, zoneID)
 
 	err := client.DeleteSpectrumApplication(zoneID, applicationID)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("error deleting Cloudflare Spectrum Application: %s", err)
 	}
 
@@ -3,7 +3,7 @@ package cloudflare
 import (
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,9 +18,21 @@ func resourceCloudflareVirtualDNS() *schema.Resource {
 		Update: resourceCloudflareVirtualDNSUpdate,
 		Delete: resourceCloudflareVirtualDNSDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceCloudflareAccountScopedImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -76,13 +88,14 @@ func resourceCloudflareVirtualDNS() *schema.Resource {
 }
 
 func resourceCloudflareVirtualDNSCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	virtualDNS := &cloudflare.VirtualDNS{
 		Name:            d.Get("name").(string),
 		OriginIPs:       expandInterfaceToStringList(d.Get("origin_ips").(*schema.Set).List()),
-		MinimumCacheTTL: d.Get("minimum_cache_ttl").(uint),
-		MaximumCacheTTL: d.Get("maximum_cache_ttl").(uint),
+		MinimumCacheTTL: uint(d.Get("minimum_cache_ttl").(int)),
+		MaximumCacheTTL: uint(d.Get("maximum_cache_ttl").(int)),
 	}
 	if val, ok := d.GetOk("deprecate_any_requests"); ok {
 		virtualDNS.DeprecateAnyRequests = val.(bool)
@@ -91,11 +104,15 @@ func resourceCloudflareVirtualDNSCreate(d *schema.ResourceData, meta interface{}
 		virtualDNS.EcsFallback = val.(bool)
 	}
 	if val, ok := d.GetOk("ratelimit"); ok {
-		virtualDNS.RateLimit = val.(uint)
+		virtualDNS.RateLimit = uint(val.(int))
 	}
 
 	log.Printf("[DEBUG] Creating Cloudflare VirtualDNS from struct: %+v", virtualDNS)
 
+	if client.OrganizationID == "" {
+		return fmt.Errorf("account_id must be set on the resource or the provider to create a virtual dns cluster")
+	}
+
 	res, err := client.CreateOrganizationVirtualDNS(client.OrganizationID, virtualDNS)
 	if err != nil {
 		return errors.Wrap(err, "error creating virtual dns")
@@ -113,14 +130,15 @@ func resourceCloudflareVirtualDNSCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareVirtualDNSUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	virtualDNS := &cloudflare.VirtualDNS{
 		ID:              d.Id(),
 		Name:            d.Get("name").(string),
 		OriginIPs:       expandInterfaceToStringList(d.Get("origin_ips").(*schema.Set).List()),
-		MinimumCacheTTL: d.Get("minimum_cache_ttl").(uint),
-		MaximumCacheTTL: d.Get("maximum_cache_ttl").(uint),
+		MinimumCacheTTL: uint(d.Get("minimum_cache_ttl").(int)),
+		MaximumCacheTTL: uint(d.Get("maximum_cache_ttl").(int)),
 	}
 	if val, ok := d.GetOk("virtual_dns_ips"); ok {
 		virtualDNS.VirtualDNSIPs = expandInterfaceToStringList(val.(*schema.Set).List())
@@ -132,7 +150,7 @@ func resourceCloudflareVirtualDNSUpdate(d *schema.ResourceData, meta interface{}
 		virtualDNS.EcsFallback = val.(bool)
 	}
 	if val, ok := d.GetOk("ratelimit"); ok {
-		virtualDNS.RateLimit = val.(uint)
+		virtualDNS.RateLimit = uint(val.(int))
 	}
 
 	log.Printf("[DEBUG] Updating Cloudflare VirtualDNS from struct: %+v", virtualDNS)
@@ -146,11 +164,11 @@ func resourceCloudflareVirtualDNSUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareVirtualDNSRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
 
 	virtualDNS, err := client.OrganizationVirtualDNS(client.OrganizationID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] VirtualDNS %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -162,6 +180,7 @@ func resourceCloudflareVirtualDNSRead(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Read VirtualDNSfrom API as struct: %+v", virtualDNS)
 
+	d.Set("account_id", client.OrganizationID)
 	d.Set("name", virtualDNS.Name)
 	d.Set("origin_ips", schema.NewSet(schema.HashString, flattenStringList(virtualDNS.OriginIPs)))
 	d.Set("virtual_dns_ips", schema.NewSet(schema.HashString, flattenStringList(virtualDNS.VirtualDNSIPs)))
@@ -175,12 +194,13 @@ func resourceCloudflareVirtualDNSRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCloudflareVirtualDNSDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	log.Printf("[INFO] Deleting Cloudflare VirtualDNS: %s", d.Id())
 
 	err := client.DeleteOrganizationVirtualDNS(client.OrganizationID, d.Id())
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.Wrap(err, "error deleting Cloudflare VirtualDNS")
 	}
 
@@ -2,9 +2,10 @@ package cloudflare
 
 import (
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -20,6 +21,12 @@ func resourceCloudflareWAFRule() *schema.Resource {
 		},
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rule_id": {
 				Type:     schema.TypeString,
@@ -50,7 +57,7 @@ func resourceCloudflareWAFRule() *schema.Resource {
 }
 
 func resourceCloudflareWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	ruleID := d.Get("rule_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -58,6 +65,11 @@ func resourceCloudflareWAFRuleRead(d *schema.ResourceData, meta interface{}) err
 
 	rule, err := client.WAFRule(zoneID, packageID, ruleID)
 	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[INFO] WAF Rule %s no longer exists", ruleID)
+			d.SetId("")
+			return nil
+		}
 		return (err)
 	}
 
@@ -69,7 +81,8 @@ func resourceCloudflareWAFRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceCloudflareWAFRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	ruleID := d.Get("rule_id").(string)
 	zone := d.Get("zone").(string)
 	mode := d.Get("mode").(string)
@@ -112,7 +125,8 @@ func resourceCloudflareWAFRuleCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceCloudflareWAFRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	ruleID := d.Get("rule_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -120,6 +134,9 @@ func resourceCloudflareWAFRuleDelete(d *schema.ResourceData, meta interface{}) e
 
 	rule, err := client.WAFRule(zoneID, packageID, ruleID)
 	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
 		return err
 	}
 
@@ -135,7 +152,8 @@ func resourceCloudflareWAFRuleDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceCloudflareWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	ruleID := d.Get("rule_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -152,7 +170,7 @@ func resourceCloudflareWAFRuleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceCloudflareWAFRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -172,11 +190,14 @@ func resourceCloudflareWAFRuleImport(d *schema.ResourceData, meta interface{}) (
 
 	packs, err := client.ListWAFPackages(zoneID)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("error listing WAF packages for zone %q: %s", zoneName, err)
 	}
 
 	for _, p := range packs {
 		rule, err := client.WAFRule(zoneID, p.ID, WAFID)
+		if err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("error finding WAF Rule %s in package %s: %s", WAFID, p.ID, err)
+		}
 		if err == nil {
 			d.Set("rule_id", rule.ID)
 			d.Set("zone", zoneName)
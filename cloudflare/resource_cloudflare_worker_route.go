@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,12 @@ func resourceCloudflareWorkerRoute() *schema.Resource {
 			State: resourceCloudflareWorkerRouteImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:     schema.TypeString,
@@ -74,7 +81,8 @@ func getRouteFromResource(d *schema.ResourceData) cloudflare.WorkerRoute {
 }
 
 func resourceCloudflareWorkerRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	route := getRouteFromResource(d)
 
 	zoneName := d.Get("zone").(string)
@@ -104,7 +112,7 @@ func resourceCloudflareWorkerRouteCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceCloudflareWorkerRouteRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneId := d.Get("zone_id").(string)
 	routeId := d.Id()
 
@@ -143,7 +151,8 @@ func resourceCloudflareWorkerRouteRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCloudflareWorkerRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneId := d.Get("zone_id").(string)
 	route := getRouteFromResource(d)
 
@@ -159,14 +168,15 @@ func resourceCloudflareWorkerRouteUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceCloudflareWorkerRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneId := d.Get("zone_id").(string)
 	route := getRouteFromResource(d)
 
 	log.Printf("[INFO] Deleting Cloudflare Worker Route from zone %+v with id: %+v", zoneId, route.ID)
 
 	_, err := client.DeleteWorkerRoute(zoneId, route.ID)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return errors.Wrap(err, "error deleting worker route")
 	}
 
@@ -174,7 +184,7 @@ func resourceCloudflareWorkerRouteDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceCloudflareWorkerRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	isEnterpriseWorker := false
 
 	// split the id so we can lookup
@@ -189,7 +199,14 @@ func resourceCloudflareWorkerRouteImport(d *schema.ResourceData, meta interface{
 	}
 
 	zoneID, err := client.ZoneIDByName(zoneName)
+	if err != nil {
+		return nil, fmt.Errorf("error finding zoneName %q: %s", zoneName, err)
+	}
+
 	routes, err := client.ListWorkerRoutes(zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading worker routes")
+	}
 
 	for _, r := range routes.Routes {
 		if r.ID == routeId && client.OrganizationID != "" {
@@ -197,10 +214,6 @@ func resourceCloudflareWorkerRouteImport(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if err != nil {
-		return nil, fmt.Errorf("error finding zoneName %q: %s", zoneName, err)
-	}
-
 	d.Set("zone", zoneName)
 	d.Set("zone_id", zoneID)
 	d.Set("multi_script", isEnterpriseWorker)
@@ -235,7 +235,7 @@ func getRouteFromApi(zoneId, routeId string) (cloudflare.WorkerRoute, error) {
 		return cloudflare.WorkerRoute{}, fmt.Errorf("routeId is required to get a route")
 	}
 
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*providerClient)
 	resp, err := client.ListWorkerRoutes(zoneId)
 	if err != nil {
 		return cloudflare.WorkerRoute{}, err
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,12 @@ func resourceCloudflareWorkerScript() *schema.Resource {
 			State: resourceCloudflareWorkerScriptImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:     schema.TypeString,
@@ -58,7 +65,7 @@ type ScriptData struct {
 	Params cloudflare.WorkerRequestParams
 }
 
-func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData, error) {
+func getScriptData(d *schema.ResourceData, client *providerClient) (ScriptData, error) {
 	zoneName := d.Get("zone").(string)
 	scriptName := d.Get("name").(string)
 	if zoneName == "" && scriptName == "" {
@@ -92,7 +99,8 @@ func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData,
 }
 
 func resourceCloudflareWorkerScriptCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
@@ -123,7 +131,7 @@ func resourceCloudflareWorkerScriptCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareWorkerScriptRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
@@ -134,7 +142,7 @@ func resourceCloudflareWorkerScriptRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		// If the resource is deleted, we should set the ID to "" and not
 		// return an error according to the terraform spec
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -148,7 +156,8 @@ func resourceCloudflareWorkerScriptRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareWorkerScriptUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
@@ -171,7 +180,8 @@ func resourceCloudflareWorkerScriptUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareWorkerScriptDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
@@ -184,7 +194,7 @@ func resourceCloudflareWorkerScriptDelete(d *schema.ResourceData, meta interface
 	if err != nil {
 		// If the resource is already deleted, we should return without an error
 		// according to the terraform spec
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			return nil
 		}
 
@@ -195,7 +205,7 @@ func resourceCloudflareWorkerScriptDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareWorkerScriptImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), ":", 2)
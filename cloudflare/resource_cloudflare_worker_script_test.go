@@ -173,7 +173,7 @@ func testAccCheckCloudflareWorkerScriptExists(n string, script *cloudflare.Worke
 			return fmt.Errorf("No Worker Script ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		params := getRequestParamsFromResource(rs)
 		r, err := client.DownloadWorker(&params)
 		if err != nil {
@@ -195,7 +195,7 @@ func testAccCheckCloudflareWorkerScriptDestroy(s *terraform.State) error {
 			continue
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		params := getRequestParamsFromResource(rs)
 		r, _ := client.DownloadWorker(&params)
 
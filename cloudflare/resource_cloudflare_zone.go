@@ -1,11 +1,13 @@
 package cloudflare
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +22,15 @@ const (
 	planIDEnterprise = "enterprise"
 )
 
+// Zone types: full zones use Cloudflare's nameservers, partial zones (CNAME
+// setup) keep their DNS elsewhere and secondary zones are transferred from a
+// primary DNS provider.
+const (
+	zoneTypeFull      = "full"
+	zoneTypePartial   = "partial"
+	zoneTypeSecondary = "secondary"
+)
+
 // we keep a private map and we will have a function to check and validate the descriptive name from the RatePlan API with the legacy_id
 var idForName = map[string]string{
 	"Free Website":       planIDFree,
@@ -38,6 +49,12 @@ func resourceCloudflareZone() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:         schema.TypeString,
@@ -88,6 +105,13 @@ func resourceCloudflareZone() *schema.Resource {
 				Computed: true,
 			},
 			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{zoneTypeFull, zoneTypePartial, zoneTypeSecondary}, false),
+			},
+			"verification_key": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -98,28 +122,42 @@ func resourceCloudflareZone() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"custom_name_servers_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"custom_name_servers_set": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
 		},
 	}
 }
 
 func resourceCloudflareZoneCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	zoneName := d.Get("zone").(string)
 	jumpstart := d.Get("jump_start").(bool)
-	organization := cloudflare.Organization{
-		ID: client.OrganizationID,
+	zoneType := zoneTypeFull
+	if v, ok := d.GetOk("type"); ok {
+		zoneType = v.(string)
 	}
 
-	log.Printf("[INFO] Creating Cloudflare Zone: name %s", zoneName)
+	log.Printf("[INFO] Creating Cloudflare Zone: name %s, type %s", zoneName, zoneType)
 
-	zone, err := client.CreateZone(zoneName, jumpstart, organization)
+	zone, err := createZone(client, zoneName, jumpstart, zoneType)
 
 	if err != nil {
 		return fmt.Errorf("Error creating zone %q: %s", zoneName, err)
 	}
 
 	d.SetId(zone.ID)
+	client.zones.Add(zone)
 
 	if paused, ok := d.GetOk("paused"); ok {
 		if paused.(bool) == true {
@@ -132,7 +170,13 @@ func resourceCloudflareZoneCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if plan, ok := d.GetOk("plan"); ok {
-		if err := setRatePlan(client, zone.ID, plan.(string)); err != nil {
+		if err := setRatePlan(client.API, zone.ID, plan.(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("custom_name_servers_enabled").(bool) {
+		if err := setZoneCustomNameservers(client, zone.ID, true, d.Get("custom_name_servers_set").(int)); err != nil {
 			return err
 		}
 	}
@@ -141,7 +185,7 @@ func resourceCloudflareZoneCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Id()
 
 	zone, err := client.ZoneDetails(zoneID)
@@ -150,8 +194,9 @@ func resourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] ZoneDetails error: %#v", err)
 
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Zone %s no longer exists", d.Id())
+			client.zones.Remove(zoneID)
 			d.SetId("")
 			return nil
 		}
@@ -167,11 +212,38 @@ func resourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("zone", zone.Name)
 	d.Set("plan", planIDForName(zone.Plan.Name))
 
+	verificationKey := ""
+	if zone.Type == zoneTypePartial {
+		verificationKey, err = getZoneVerificationKey(client, zoneID)
+		if err != nil {
+			return fmt.Errorf("Error finding the verification key of zone %q: %s", zoneID, err)
+		}
+	}
+	d.Set("verification_key", verificationKey)
+
+	// custom nameservers aren't available to every zone, so their setting is
+	// only read for zones that use them, or that are being imported
+	if d.Get("custom_name_servers_enabled").(bool) || d.Get("custom_name_servers_set").(int) != 1 {
+		customNS, err := getZoneCustomNameservers(client, zoneID)
+		switch {
+		case err == nil:
+			d.Set("custom_name_servers_enabled", customNS.Enabled)
+			d.Set("custom_name_servers_set", customNS.NSSet)
+		case isNotFound(err):
+			log.Printf("[DEBUG] Zone %q has no custom nameservers setting: %s", zoneID, err)
+			d.Set("custom_name_servers_enabled", false)
+			d.Set("custom_name_servers_set", 1)
+		default:
+			return fmt.Errorf("Error reading the custom nameservers setting of zone %q: %s", zoneID, err)
+		}
+	}
+
 	return nil
 }
 
 func resourceCloudflareZoneUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Id()
 
 	log.Printf("[INFO] Updating Cloudflare Zone: id %s", zoneID)
@@ -187,7 +259,13 @@ func resourceCloudflareZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if plan, ok := d.GetOk("plan"); ok {
-		if err := setRatePlan(client, zoneID, plan.(string)); err != nil {
+		if err := setRatePlan(client.API, zoneID, plan.(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("custom_name_servers_enabled") || d.HasChange("custom_name_servers_set") {
+		if err := setZoneCustomNameservers(client, zoneID, d.Get("custom_name_servers_enabled").(bool), d.Get("custom_name_servers_set").(int)); err != nil {
 			return err
 		}
 	}
@@ -196,20 +274,61 @@ func resourceCloudflareZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCloudflareZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Id()
 
 	log.Printf("[INFO] Deleting Cloudflare Zone: id %s", zoneID)
 
 	_, err := client.DeleteZone(zoneID)
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error deleting Cloudflare Zone: %s", err)
 	}
 
+	client.zones.Remove(zoneID)
+
 	return nil
 }
 
+// createZone creates a zone of the given type, which the SDK's CreateZone
+// doesn't support yet.
+func createZone(client *providerClient, name string, jumpstart bool, zoneType string) (cloudflare.Zone, error) {
+	newZone := map[string]interface{}{
+		"name":       name,
+		"jump_start": jumpstart,
+		"type":       zoneType,
+	}
+	if client.OrganizationID != "" {
+		newZone["organization"] = cloudflare.Organization{ID: client.OrganizationID}
+	}
+
+	var zone cloudflare.Zone
+	res, err := client.Raw("POST", "/zones", newZone)
+	if err != nil {
+		return zone, err
+	}
+
+	err = json.Unmarshal(res, &zone)
+	return zone, err
+}
+
+// getZoneVerificationKey returns the value of the TXT record that verifies
+// the ownership of a partial zone, which the SDK's Zone doesn't have.
+func getZoneVerificationKey(client *providerClient, zoneID string) (string, error) {
+	var zone struct {
+		VerificationKey string `json:"verification_key"`
+	}
+
+	res, err := client.Raw("GET", "/zones/"+zoneID, nil)
+	if err != nil {
+		return "", err
+	}
+
+	err = json.Unmarshal(res, &zone)
+	return zone.VerificationKey, err
+}
+
 func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]interface{} {
 	cfg := map[string]interface{}{}
 
@@ -221,6 +340,36 @@ func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]in
 	return cfg
 }
 
+// zoneCustomNameservers is whether a zone uses the custom nameservers of a set
+// of its account, which the SDK doesn't support yet.
+type zoneCustomNameservers struct {
+	Enabled bool `json:"enabled"`
+	NSSet   int  `json:"ns_set"`
+}
+
+func getZoneCustomNameservers(client *providerClient, zoneID string) (zoneCustomNameservers, error) {
+	var customNS zoneCustomNameservers
+
+	res, err := client.Raw("GET", "/zones/"+zoneID+"/custom_ns", nil)
+	if err != nil {
+		return customNS, err
+	}
+
+	err = json.Unmarshal(res, &customNS)
+	return customNS, err
+}
+
+func setZoneCustomNameservers(client *providerClient, zoneID string, enabled bool, nsSet int) error {
+	log.Printf("[INFO] Setting zone %q to use custom nameservers: %t (set %d)", zoneID, enabled, nsSet)
+
+	customNS := zoneCustomNameservers{Enabled: enabled, NSSet: nsSet}
+	if _, err := client.Raw("PUT", "/zones/"+zoneID+"/custom_ns", customNS); err != nil {
+		return fmt.Errorf("Error setting the custom nameservers of zone %q: %s", zoneID, err)
+	}
+
+	return nil
+}
+
 func setRatePlan(client *cloudflare.API, zoneID string, planID string) error {
 	plan, err := getAvailableZonePlan(client, zoneID, planID)
 	if err != nil {
@@ -0,0 +1,128 @@
+package cloudflare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Zone statuses reported by the API while a zone is being activated.
+const (
+	zoneStatusInitializing = "initializing"
+	zoneStatusPending      = "pending"
+	zoneStatusActive       = "active"
+)
+
+func resourceCloudflareZoneActivationCheck() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareZoneActivationCheckCreate,
+		Read:   resourceCloudflareZoneActivationCheckRead,
+		Delete: resourceCloudflareZoneActivationCheckDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareZoneActivationCheckImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneActivationCheckCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	zoneID := d.Get("zone_id").(string)
+
+	if err := activateZone(client, zoneID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareZoneActivationCheckRead(d, meta)
+}
+
+func resourceCloudflareZoneActivationCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient)
+
+	zone, err := client.ZoneDetails(d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Removing activation check from state because zone %q is not found in API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding Zone %q: %s", d.Id(), err)
+	}
+
+	d.Set("zone_id", d.Id())
+	d.Set("status", zone.Status)
+
+	return nil
+}
+
+// resourceCloudflareZoneActivationCheckDelete only removes the activation
+// check from state, there is no way to deactivate a zone but deleting it.
+func resourceCloudflareZoneActivationCheckDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceCloudflareZoneActivationCheckImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// activateZone requests an activation check of a zone that isn't active yet
+// and waits for it to become active. The check passes once the zone's
+// nameservers, or for partial zones its verification TXT record, are in
+// place.
+func activateZone(client *providerClient, zoneID string, timeout time.Duration) error {
+	zone, err := client.ZoneDetails(zoneID)
+	if err != nil {
+		return fmt.Errorf("Error finding Zone %q: %s", zoneID, err)
+	}
+	if zone.Status == zoneStatusActive {
+		return nil
+	}
+
+	log.Printf("[INFO] Requesting an activation check of zone %q", zoneID)
+
+	if _, err := client.ZoneActivationCheck(zoneID); err != nil {
+		return fmt.Errorf("Error requesting an activation check of zone %q: %s", zoneID, err)
+	}
+
+	conf := &resource.StateChangeConf{
+		Pending: []string{zoneStatusInitializing, zoneStatusPending},
+		Target:  []string{zoneStatusActive},
+		Refresh: func() (interface{}, string, error) {
+			zone, err := client.ZoneDetails(zoneID)
+			if err != nil {
+				return nil, "", err
+			}
+			return zone, zone.Status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := conf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for zone %q to become active: %s", zoneID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareZoneActivationCheck_Basic(t *testing.T) {
+	zoneName := "tf-acctest-activation.example.net"
+	name := "cloudflare_zone_activation_check.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneActivationCheckConfig(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneStatus("cloudflare_zone.test", zoneStatusActive),
+					resource.TestCheckResourceAttrPair(name, "zone_id", "cloudflare_zone.test", "id"),
+					resource.TestCheckResourceAttr(name, "status", zoneStatusActive),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneStatus(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		zone, err := client.ZoneDetails(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if zone.Status != status {
+			return fmt.Errorf("expected zone status %q, got %q", status, zone.Status)
+		}
+		return nil
+	}
+}
+
+func testAccCloudflareZoneActivationCheckConfig(zoneName string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone" "test" {
+  zone = "%[1]s"
+  type = "partial"
+}
+
+resource "cloudflare_zone_activation_check" "test" {
+  zone_id = "${cloudflare_zone.test.id}"
+}`, zoneName)
+}
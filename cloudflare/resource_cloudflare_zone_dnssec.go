@@ -0,0 +1,237 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// DNSSEC statuses reported by the API.
+const (
+	dnssecStatusActive          = "active"
+	dnssecStatusPending         = "pending"
+	dnssecStatusDisabled        = "disabled"
+	dnssecStatusPendingDisabled = "pending-disabled"
+)
+
+// zoneDNSSEC is the DNSSEC configuration of a zone, which the SDK doesn't
+// support yet.
+type zoneDNSSEC struct {
+	Status          string `json:"status"`
+	Flags           int    `json:"flags"`
+	Algorithm       string `json:"algorithm"`
+	KeyType         string `json:"key_type"`
+	DigestType      string `json:"digest_type"`
+	DigestAlgorithm string `json:"digest_algorithm"`
+	Digest          string `json:"digest"`
+	DS              string `json:"ds"`
+	KeyTag          int    `json:"key_tag"`
+	PublicKey       string `json:"public_key"`
+	ModifiedOn      string `json:"modified_on"`
+}
+
+func resourceCloudflareZoneDNSSEC() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareZoneDNSSECCreate,
+		Read:   resourceCloudflareZoneDNSSECRead,
+		Update: resourceCloudflareZoneDNSSECUpdate,
+		Delete: resourceCloudflareZoneDNSSECDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareZoneDNSSECImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flags": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"digest_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"digest_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ds": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_tag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneDNSSECCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	zoneID := d.Get("zone_id").(string)
+
+	if err := setZoneDNSSEC(client, zoneID, d.Get("enabled").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareZoneDNSSECRead(d, meta)
+}
+
+func resourceCloudflareZoneDNSSECRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient)
+
+	dnssec, err := getZoneDNSSEC(client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Removing DNSSEC configuration from state because zone %q is not found in API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DNSSEC configuration of zone %q: %s", d.Id(), err)
+	}
+
+	d.Set("zone_id", d.Id())
+	d.Set("enabled", dnssec.Status != dnssecStatusDisabled && dnssec.Status != dnssecStatusPendingDisabled)
+	d.Set("status", dnssec.Status)
+	d.Set("flags", dnssec.Flags)
+	d.Set("algorithm", dnssec.Algorithm)
+	d.Set("key_type", dnssec.KeyType)
+	d.Set("digest_type", dnssec.DigestType)
+	d.Set("digest_algorithm", dnssec.DigestAlgorithm)
+	d.Set("digest", dnssec.Digest)
+	d.Set("ds", dnssec.DS)
+	d.Set("key_tag", dnssec.KeyTag)
+	d.Set("public_key", dnssec.PublicKey)
+	d.Set("modified_on", dnssec.ModifiedOn)
+
+	return nil
+}
+
+func resourceCloudflareZoneDNSSECUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if d.HasChange("enabled") {
+		if err := setZoneDNSSEC(client, d.Id(), d.Get("enabled").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudflareZoneDNSSECRead(d, meta)
+}
+
+func resourceCloudflareZoneDNSSECDelete(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	err := setZoneDNSSEC(client, d.Id(), false, d.Timeout(schema.TimeoutDelete))
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneDNSSECImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getZoneDNSSEC(client *providerClient, zoneID string) (zoneDNSSEC, error) {
+	var dnssec zoneDNSSEC
+
+	res, err := client.Raw("GET", "/zones/"+zoneID+"/dnssec", nil)
+	if err != nil {
+		return dnssec, err
+	}
+
+	err = json.Unmarshal(res, &dnssec)
+	return dnssec, err
+}
+
+// setZoneDNSSEC enables or disables DNSSEC for a zone and waits for the
+// change to take effect. Enabling DNSSEC is done once the status is active,
+// or pending until the DS record is added at the registrar.
+func setZoneDNSSEC(client *providerClient, zoneID string, enabled bool, timeout time.Duration) error {
+	status := dnssecStatusActive
+	pending := []string{dnssecStatusDisabled, dnssecStatusPendingDisabled}
+	target := []string{dnssecStatusActive, dnssecStatusPending}
+	if !enabled {
+		status = dnssecStatusDisabled
+		// like pending for enabling, pending-disabled waits on the registrar
+		pending, target = []string{dnssecStatusActive, dnssecStatusPending}, []string{dnssecStatusDisabled, dnssecStatusPendingDisabled}
+	}
+
+	log.Printf("[INFO] Setting the DNSSEC status of zone %q to %s", zoneID, status)
+
+	_, err := client.Raw("PATCH", "/zones/"+zoneID+"/dnssec", map[string]interface{}{"status": status})
+	if err != nil {
+		return fmt.Errorf("Error setting the DNSSEC status of zone %q to %s: %s", zoneID, status, err)
+	}
+
+	conf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			dnssec, err := getZoneDNSSEC(client, zoneID)
+			if err != nil {
+				return nil, "", err
+			}
+			return dnssec, dnssec.Status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: time.Second,
+	}
+	if _, err := conf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the DNSSEC status of zone %q to become %s: %s", zoneID, status, err)
+	}
+
+	return nil
+}
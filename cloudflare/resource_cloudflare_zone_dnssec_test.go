@@ -0,0 +1,82 @@
+package cloudflare
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareZoneDNSSEC_Basic(t *testing.T) {
+	zoneName := "tf-acctest-dnssec.example.net"
+	name := "cloudflare_zone_dnssec.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneDNSSECConfig(zoneName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneDNSSECStatus(name, "^(active|pending)$"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestMatchResourceAttr(name, "status", regexp.MustCompile("^(active|pending)$")),
+					resource.TestMatchResourceAttr(name, "ds", regexp.MustCompile("^"+regexp.QuoteMeta(zoneName)+`\. \d+ IN DS \d+ \d+ \d+ [0-9A-F]+$`)),
+					resource.TestCheckResourceAttrSet(name, "digest"),
+					resource.TestCheckResourceAttrSet(name, "digest_type"),
+					resource.TestCheckResourceAttrSet(name, "algorithm"),
+					resource.TestCheckResourceAttrSet(name, "key_tag"),
+					resource.TestCheckResourceAttr(name, "flags", "257"),
+					resource.TestCheckResourceAttrSet(name, "public_key"),
+					resource.TestCheckResourceAttrPair(name, "zone_id", "cloudflare_zone.test", "id"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudflareZoneDNSSECConfig(zoneName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneDNSSECStatus(name, "^(disabled|pending-disabled)$"),
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestMatchResourceAttr(name, "status", regexp.MustCompile("^(disabled|pending-disabled)$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneDNSSECStatus(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		dnssec, err := getZoneDNSSEC(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !regexp.MustCompile(status).MatchString(dnssec.Status) {
+			return fmt.Errorf("expected DNSSEC status to match %q, got %q", status, dnssec.Status)
+		}
+		return nil
+	}
+}
+
+func testAccCloudflareZoneDNSSECConfig(zoneName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone" "test" {
+  zone = "%[1]s"
+}
+
+resource "cloudflare_zone_dnssec" "test" {
+  zone_id = "${cloudflare_zone.test.id}"
+  enabled = %[2]t
+}`, zoneName, enabled)
+}
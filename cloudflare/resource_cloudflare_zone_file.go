@@ -0,0 +1,285 @@
+package cloudflare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCloudflareZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareZoneFileCreate,
+		Read:   resourceCloudflareZoneFileRead,
+		Update: resourceCloudflareZoneFileUpdate,
+		Delete: resourceCloudflareZoneFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareZoneFileImport,
+		},
+
+		CustomizeDiff: resourceCloudflareZoneFileCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressZoneFileDiff,
+			},
+
+			"record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneFileCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	zoneName := d.Get("zone").(string)
+
+	zoneID, err := client.ZoneIDByName(zoneName)
+	if err != nil {
+		return fmt.Errorf("Error finding zone %q: %s", zoneName, err)
+	}
+
+	records, err := parseZoneFile(d.Get("content").(string), zoneName)
+	if err != nil {
+		return fmt.Errorf("Error parsing zone file for zone %q: %s", zoneName, err)
+	}
+
+	if err := reconcileZoneFile(client, zoneID, zoneName, records); err != nil {
+		return err
+	}
+
+	d.SetId(zoneID)
+	d.Set("zone_id", zoneID)
+
+	return resourceCloudflareZoneFileRead(d, meta)
+}
+
+func resourceCloudflareZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient)
+	zoneName := d.Get("zone").(string)
+
+	records, err := client.DNSRecords(d.Id(), cloudflare.DNSRecord{})
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Removing zone file from state because zone %q is not found in API", zoneName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing DNS records of zone %q: %s", zoneName, err)
+	}
+
+	d.Set("zone_id", d.Id())
+	d.Set("content", renderZoneFile(records, zoneName))
+	d.Set("record_count", len(records))
+
+	return nil
+}
+
+func resourceCloudflareZoneFileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+	zoneName := d.Get("zone").(string)
+
+	records, err := parseZoneFile(d.Get("content").(string), zoneName)
+	if err != nil {
+		return fmt.Errorf("Error parsing zone file for zone %q: %s", zoneName, err)
+	}
+
+	if err := reconcileZoneFile(client, d.Id(), zoneName, records); err != nil {
+		return err
+	}
+
+	return resourceCloudflareZoneFileRead(d, meta)
+}
+
+func resourceCloudflareZoneFileDelete(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	zoneName := d.Get("zone").(string)
+
+	records, err := parseZoneFile(d.Get("content").(string), zoneName)
+	if err != nil {
+		return fmt.Errorf("Error parsing zone file for zone %q: %s", zoneName, err)
+	}
+
+	managed := make(map[string]bool, len(records))
+	for _, record := range records {
+		managed[zoneFileRecordKey(record)] = true
+	}
+
+	existing, err := client.DNSRecords(d.Id(), cloudflare.DNSRecord{})
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error listing DNS records of zone %q: %s", zoneName, err)
+	}
+
+	for _, record := range existing {
+		if !managed[zoneFileRecordKey(record)] {
+			continue
+		}
+		log.Printf("[INFO] Deleting Cloudflare Record: %s %s %s", record.Name, record.Type, record.ID)
+		if err := client.DeleteDNSRecord(d.Id(), record.ID); err != nil && !isNotFound(err) {
+			return fmt.Errorf("Error deleting %s record %q: %s", record.Type, record.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneFileImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
+	zoneName := d.Id()
+
+	zoneID, err := client.ZoneIDByName(zoneName)
+	if err != nil {
+		return nil, fmt.Errorf("error finding zone %q: %s", zoneName, err)
+	}
+
+	d.Set("zone", zoneName)
+	d.Set("zone_id", zoneID)
+	d.SetId(zoneID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareZoneFileCustomizeDiff reports zone file syntax errors at
+// plan time rather than part way through reconciling the records.
+func resourceCloudflareZoneFileCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("content") || !d.NewValueKnown("zone") {
+		return nil
+	}
+
+	zoneName := d.Get("zone").(string)
+	if _, err := parseZoneFile(d.Get("content").(string), zoneName); err != nil {
+		return fmt.Errorf("invalid zone file for zone %q: %s", zoneName, err)
+	}
+	return nil
+}
+
+// reconcileZoneFile makes the records of a zone match records. Records that
+// only differ in TTL or proxying, and records replaced by one of the same name
+// and type, are updated in place. The remaining records are deleted before the
+// new ones are created, so that e.g. a CNAME can replace an A record. The
+// records Cloudflare manages are left alone, like they're skipped when parsing.
+func reconcileZoneFile(client *providerClient, zoneID, zoneName string, records []cloudflare.DNSRecord) error {
+	existing, err := client.DNSRecords(zoneID, cloudflare.DNSRecord{})
+	if err != nil {
+		return fmt.Errorf("Error listing DNS records of zone %q: %s", zoneID, err)
+	}
+
+	byKey := make(map[string][]cloudflare.DNSRecord)
+	for _, record := range existing {
+		key := zoneFileRecordKey(record)
+		byKey[key] = append(byKey[key], record)
+	}
+
+	var updates [][2]cloudflare.DNSRecord
+	var creates []cloudflare.DNSRecord
+	matched := make(map[string]bool)
+	for _, record := range records {
+		key := zoneFileRecordKey(record)
+		if matches := byKey[key]; len(matches) > 0 {
+			byKey[key] = matches[1:]
+			matched[matches[0].ID] = true
+			if matches[0].TTL != record.TTL || matches[0].Proxied != record.Proxied {
+				updates = append(updates, [2]cloudflare.DNSRecord{matches[0], record})
+			}
+			continue
+		}
+		creates = append(creates, record)
+	}
+
+	var stale []cloudflare.DNSRecord
+	for _, record := range existing {
+		if !matched[record.ID] && !zoneFileManagedByCloudflare(record.Name, record.Type, zoneName) {
+			stale = append(stale, record)
+		}
+	}
+
+	// pair up records replaced by one of the same name and type
+	var remaining []cloudflare.DNSRecord
+	for _, record := range creates {
+		replaced := -1
+		for i, old := range stale {
+			if old.Name == record.Name && old.Type == record.Type {
+				replaced = i
+				break
+			}
+		}
+		if replaced < 0 {
+			remaining = append(remaining, record)
+			continue
+		}
+		updates = append(updates, [2]cloudflare.DNSRecord{stale[replaced], record})
+		stale = append(stale[:replaced], stale[replaced+1:]...)
+	}
+
+	for _, record := range stale {
+		log.Printf("[INFO] Deleting Cloudflare Record: %s %s %s", record.Name, record.Type, record.ID)
+		if err := client.DeleteDNSRecord(zoneID, record.ID); err != nil && !isNotFound(err) {
+			return fmt.Errorf("Error deleting %s record %q: %s", record.Type, record.Name, err)
+		}
+	}
+
+	for _, update := range updates {
+		old, record := update[0], update[1]
+		record.Content = apiRecordValue(record.Type, record.Content)
+		log.Printf("[DEBUG] Cloudflare Record update configuration: %#v", record)
+		if err := client.UpdateDNSRecord(zoneID, old.ID, record); err != nil {
+			return fmt.Errorf("Error updating %s record %q: %s", record.Type, record.Name, err)
+		}
+	}
+
+	for _, record := range remaining {
+		record.Content = apiRecordValue(record.Type, record.Content)
+		log.Printf("[DEBUG] Cloudflare Record create configuration: %#v", record)
+		if _, err := client.CreateDNSRecord(zoneID, record); err != nil {
+			return fmt.Errorf("Error creating %s record %q: %s", record.Type, record.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// suppressZoneFileDiff ignores differences in formatting, ordering and
+// comments between zone files that describe the same records.
+func suppressZoneFileDiff(k, old, new string, d *schema.ResourceData) bool {
+	zoneName := d.Get("zone").(string)
+
+	oldRecords, err := parseZoneFile(old, zoneName)
+	if err != nil {
+		return false
+	}
+	newRecords, err := parseZoneFile(new, zoneName)
+	if err != nil {
+		return false
+	}
+
+	return renderZoneFile(oldRecords, zoneName) == renderZoneFile(newRecords, zoneName)
+}
@@ -0,0 +1,175 @@
+package cloudflare
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The zone file tests create their own zone, as reconciling a zone file
+// deletes every record not in it.
+const testAccCloudflareZoneFileZone = "tf-acctest-zone-file.example.net"
+
+func TestAccCloudflareZoneFile_Basic(t *testing.T) {
+	var records []cloudflare.DNSRecord
+	resourceName := "cloudflare_zone_file.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneFileConfig(`
+$TTL 3600
+@	IN	MX	10 mx
+www	300	IN	A	192.0.2.1
+www		IN	A	192.0.2.2 ; cf_tags=cf-proxied:true
+@		IN	TXT	"v=spf1 -all"
+_sip._udp	IN	SRV	10 60 5060 sip.example.net.
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneFileRecords(resourceName, &records, 5),
+					resource.TestCheckResourceAttr(resourceName, "zone", testAccCloudflareZoneFileZone),
+					resource.TestCheckResourceAttr(resourceName, "record_count", "5"),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "cloudflare_zone.test", "id"),
+				),
+			},
+			{
+				// the TTL of www and the MX target change, TXT is replaced by a CNAME
+				Config: testAccCloudflareZoneFileConfig(`
+$TTL 3600
+@	IN	MX	10 mx2
+www	600	IN	A	192.0.2.1
+www		IN	A	192.0.2.2 ; cf_tags=cf-proxied:true
+api		IN	CNAME	www
+_sip._udp	IN	SRV	10 60 5060 sip.example.net.
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneFileUpdatedInPlace(resourceName, &records, "www."+testAccCloudflareZoneFileZone, "MX"),
+					testAccCheckCloudflareZoneFileRecords(resourceName, &records, 5),
+					resource.TestCheckResourceAttr(resourceName, "record_count", "5"),
+				),
+			},
+			{
+				// formatting, ordering and comments don't matter
+				Config: testAccCloudflareZoneFileConfig(`
+$ORIGIN tf-acctest-zone-file.example.net.
+_sip._udp.tf-acctest-zone-file.example.net. 1h IN SRV ( 10 60 5060
+	sip.example.net. )
+api	1h	CNAME	www.tf-acctest-zone-file.example.net.
+www	10m	A	192.0.2.1
+	1h	A	192.0.2.2 ; cf_tags=cf-proxied:true
+@	1h	MX	10 mx2 ; mail
+`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     testAccCloudflareZoneFileZone,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudflareZoneFileConfigZoneOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneFileDeleted("cloudflare_zone.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneFile_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareZoneFileConfig("www A 192.0.2.1\nwww PTR example.net.\n"),
+				ExpectError: regexp.MustCompile(`line 2: Invalid type "PTR"`),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneFileRecords(n string, records *[]cloudflare.DNSRecord, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		found, err := client.DNSRecords(rs.Primary.ID, cloudflare.DNSRecord{})
+		if err != nil {
+			return err
+		}
+		if len(found) != count {
+			return fmt.Errorf("expected %d records, got %d", count, len(found))
+		}
+
+		*records = found
+		return nil
+	}
+}
+
+// testAccCheckCloudflareZoneFileUpdatedInPlace checks that the records of the
+// given name or type kept their IDs.
+func testAccCheckCloudflareZoneFileUpdatedInPlace(n string, records *[]cloudflare.DNSRecord, name, recordType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		for _, record := range *records {
+			if record.Name != name && record.Type != recordType {
+				continue
+			}
+			if _, err := client.DNSRecord(rs.Primary.ID, record.ID); err != nil {
+				return fmt.Errorf("expected %s record %s to be updated in place: %s", record.Type, record.Name, err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckCloudflareZoneFileDeleted(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		records, err := client.DNSRecords(rs.Primary.ID, cloudflare.DNSRecord{})
+		if err != nil {
+			return err
+		}
+		if len(records) != 0 {
+			return fmt.Errorf("expected the records of the zone file to be deleted, got %d records", len(records))
+		}
+		return nil
+	}
+}
+
+func testAccCloudflareZoneFileConfig(content string) string {
+	return testAccCloudflareZoneFileConfigZoneOnly() + fmt.Sprintf(`
+resource "cloudflare_zone_file" "test" {
+  zone    = "${cloudflare_zone.test.zone}"
+  content = <<EOF
+%[1]sEOF
+}`, content)
+}
+
+func testAccCloudflareZoneFileConfigZoneOnly() string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone" "test" {
+  zone = "%[1]s"
+}`, testAccCloudflareZoneFileZone)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,12 @@ func resourceCloudflareZoneLockdown() *schema.Resource {
 			State: resourceCloudflareZoneLockdownImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:     schema.TypeString,
@@ -73,7 +80,8 @@ func resourceCloudflareZoneLockdown() *schema.Resource {
 }
 
 func resourceCloudflareZoneLockdownCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	zoneName := d.Get("zone").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -129,7 +137,7 @@ func resourceCloudflareZoneLockdownCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	zoneLockdownResponse, err := client.ZoneLockdown(zoneID, d.Id())
@@ -138,7 +146,7 @@ func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}
 	log.Printf("[DEBUG] zoneLockdownResponse error: %#v", err)
 
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Zone Lockdown %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -171,7 +179,8 @@ func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareZoneLockdownUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	var newZoneLockdown cloudflare.ZoneLockdown
@@ -212,14 +221,15 @@ func resourceCloudflareZoneLockdownUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareZoneLockdownDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Zone Lockdown: id %s for zone %s", d.Id(), zoneID)
 
 	_, err := client.DeleteZoneLockdown(zoneID, d.Id())
 
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error deleting Cloudflare Zone Lockdown: %s", err)
 	}
 
@@ -239,7 +249,7 @@ func expandZoneLockdownConfig(configs *schema.Set) []cloudflare.ZoneLockdownConf
 }
 
 func resourceCloudflareZoneLockdownImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -3,8 +3,8 @@ package cloudflare
 import (
 	"fmt"
 	"log"
-
-	"strings"
+	"sort"
+	"strconv"
 
 	"time"
 
@@ -22,8 +22,18 @@ func resourceCloudflareZoneSettingsOverride() *schema.Resource {
 		Read:   resourceCloudflareZoneSettingsOverrideRead,
 		Update: resourceCloudflareZoneSettingsOverrideUpdate,
 		Delete: resourceCloudflareZoneSettingsOverrideDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareZoneSettingsOverrideImport,
+		},
+		CustomizeDiff: resourceCloudflareZoneSettingsOverrideCustomizeDiff,
 
 		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -105,7 +115,8 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 		ValidateFunc: validateIntInSlice([]int{0, 30, 60, 300, 1200, 1800, 3600, 7200, 10800, 14400, 18000, 28800,
 			43200, 57600, 72000, 86400, 172800, 259200, 345600, 432000, 691200, 1382400, 2073600, 2678400, 5356800,
 			16070400, 31536000}),
-		// minimum TTL available depends on the plan level of the zone.
+		// minimum TTL available depends on the plan level of the zone, see
+		// validateZoneSettingForPlan.
 		// - Respect existing headers = 0
 		// - Enterprise = 30
 		// - Business, Pro, Free = 1800
@@ -436,9 +447,10 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 	"always_use_https": {
 		// may cause an error: HTTP status 400: content "{\"success\":false,\"errors\":[{\"code\":1016,\"message\":\"An unknown error has occurred\"}],\"messages\":[],\"result\":null}"
 		// but it still gets set at the API
-		Type:     schema.TypeString,
-		Optional: true,
-		Computed: true,
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
 	},
 
 	"sha1_support": {
@@ -458,6 +470,9 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 		Type:     schema.TypeInt,
 		Optional: true,
 		Computed: true,
+		// megabytes, the maximum depends on the plan level of the zone
+		ValidateFunc: validateIntInSlice([]int{100, 125, 150, 175, 200, 225, 250, 275, 300, 325, 350, 375, 400, 425,
+			450, 475, 500}),
 	},
 
 	"edge_cache_ttl": {
@@ -465,10 +480,302 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 	},
+
+	"zero_rtt": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"http3": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"h2_prioritization": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off", "custom"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"origin_max_http_version": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"1", "2"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"early_hints": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"image_resizing": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off", "open"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"orange_to_orange": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"visitor_ip": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"filter_logs_to_cloudflare": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"log_to_cloudflare": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"proxy_read_timeout": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(1, 6000),
+	},
+
+	"ciphers": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+
+	"nel": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	},
+
+	"automatic_platform_optimization": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"cf": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"wordpress": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"wp_plugin": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"hostnames": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+
+				"cache_by_device_type": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	},
+}
+
+// zoneSettingAPIIDs are the IDs the API uses for the settings whose IDs
+// aren't valid attribute names.
+var zoneSettingAPIIDs = map[string]string{
+	"zero_rtt": "0rtt",
+}
+
+// zoneSettingAPIID returns the ID the API uses for the setting k of the
+// schema.
+func zoneSettingAPIID(k string) string {
+	if id, ok := zoneSettingAPIIDs[k]; ok {
+		return id
+	}
+	return k
+}
+
+// zoneSettingSchemaKey returns the key of the schema for the setting the API
+// calls id.
+func zoneSettingSchemaKey(id string) string {
+	for k, apiID := range zoneSettingAPIIDs {
+		if apiID == id {
+			return k
+		}
+	}
+	return id
+}
+
+// zoneSettingPlans are the plans settings are available on from, settings
+// that aren't listed are available on every plan.
+var zoneSettingPlans = map[string]string{
+	"advanced_ddos":               planIDBusiness,
+	"h2_prioritization":           planIDPro,
+	"image_resizing":              planIDBusiness,
+	"mirage":                      planIDPro,
+	"polish":                      planIDPro,
+	"waf":                         planIDPro,
+	"webp":                        planIDPro,
+	"filter_logs_to_cloudflare":   planIDEnterprise,
+	"log_to_cloudflare":           planIDEnterprise,
+	"orange_to_orange":            planIDEnterprise,
+	"origin_error_page_pass_thru": planIDEnterprise,
+	"origin_max_http_version":     planIDEnterprise,
+	"prefetch_preload":            planIDEnterprise,
+	"proxy_read_timeout":          planIDEnterprise,
+	"sort_query_string_for_cache": planIDEnterprise,
+	"true_client_ip_header":       planIDEnterprise,
+}
+
+// zoneSettingOffValues are the values that leave the feature of a plan-gated
+// setting off, for settings where that isn't "off". Every plan accepts them.
+var zoneSettingOffValues = map[string]interface{}{
+	"origin_max_http_version": "2",
+	"proxy_read_timeout":      100,
+}
+
+// zoneSettingTurnsOn returns whether value turns the feature of the setting k
+// on, rather than leaving it off.
+func zoneSettingTurnsOn(k string, value interface{}) bool {
+	off, ok := zoneSettingOffValues[k]
+	if !ok {
+		off = "off"
+	}
+	return value != off
+}
+
+// planRanks orders the plans from the cheapest to the most expensive.
+var planRanks = map[string]int{
+	planIDFree:       0,
+	planIDPro:        1,
+	planIDBusiness:   2,
+	planIDEnterprise: 3,
+}
+
+// resourceCloudflareZoneSettingsOverrideCustomizeDiff rejects settings the
+// plan of the zone doesn't allow at plan time, rather than when the API
+// refuses to update them. Only settings that change are checked, and nothing
+// is checked for zones that don't exist yet, as their plan isn't known.
+func resourceCloudflareZoneSettingsOverrideCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("name") {
+		return nil
+	}
+
+	var changed []string
+	for k := range resourceCloudflareZoneSettingsSchema {
+		key := "settings.0." + k
+		if _, ok := d.GetOk(key); ok && d.HasChange(key) && d.NewValueKnown(key) {
+			changed = append(changed, k)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	sort.Strings(changed)
+
+	client := meta.(*providerClient)
+	zoneName := d.Get("name").(string)
+	zoneID := d.Id()
+	if zoneID == "" {
+		var err error
+		zoneID, err = client.ZoneIDByName(zoneName)
+		if err != nil {
+			log.Printf("[DEBUG] Not validating the settings of zone %q against its plan: %s", zoneName, err)
+			return nil
+		}
+	}
+
+	zone, err := client.ZoneDetails(zoneID)
+	if err != nil {
+		return fmt.Errorf("Error finding the plan of zone %q: %s", zoneName, err)
+	}
+	plan := planIDForName(zone.Plan.Name)
+	if _, ok := planRanks[plan]; !ok {
+		log.Printf("[DEBUG] Not validating the settings of zone %q against its unknown plan %q", zoneName, zone.Plan.Name)
+		return nil
+	}
+
+	for _, k := range changed {
+		if err := validateZoneSettingForPlan(k, d.Get("settings.0."+k), plan); err != nil {
+			return fmt.Errorf("Invalid setting for zone %q on the %s plan: %s", zoneName, plan, err)
+		}
+	}
+
+	return nil
+}
+
+// validateZoneSettingForPlan returns an error if value of the setting k
+// can't be used on plan. Plan-gated settings can be turned off on any plan.
+func validateZoneSettingForPlan(k string, value interface{}, plan string) error {
+	if required, ok := zoneSettingPlans[k]; ok && planRanks[plan] < planRanks[required] && zoneSettingTurnsOn(k, value) {
+		return fmt.Errorf("%s requires the %s plan or higher", k, required)
+	}
+
+	switch k {
+	case "browser_cache_ttl":
+		if ttl := value.(int); ttl > 0 && ttl < 1800 && plan != planIDEnterprise {
+			return fmt.Errorf("browser_cache_ttl below 1800 seconds, other than 0 to respect existing headers, requires the %s plan", planIDEnterprise)
+		}
+	case "security_level":
+		if value.(string) == "off" && plan != planIDEnterprise {
+			return fmt.Errorf("security_level \"off\" requires the %s plan", planIDEnterprise)
+		}
+	}
+
+	return nil
 }
 
 func resourceCloudflareZoneSettingsOverrideCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	zoneId, err := client.ZoneIDByName(d.Get("name").(string))
 	if err != nil {
@@ -502,11 +809,11 @@ func resourceCloudflareZoneSettingsOverrideCreate(d *schema.ResourceData, meta i
 }
 
 func resourceCloudflareZoneSettingsOverrideRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
 	zone, err := client.ZoneDetails(d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFound(err) {
 			log.Printf("[INFO] Zone %q not found", d.Id())
 			d.SetId("")
 			return nil
@@ -544,26 +851,58 @@ func resourceCloudflareZoneSettingsOverrideRead(d *schema.ResourceData, meta int
 	return nil
 }
 
+func resourceCloudflareZoneSettingsOverrideImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
+	zoneName := d.Id()
+
+	zoneID, err := client.ZoneIDByName(zoneName)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't find zone %q while trying to import it: %s", zoneName, err)
+	}
+
+	zoneSettings, err := client.ZoneSettings(zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error reading settings for zone %q", zoneID))
+	}
+
+	d.SetId(zoneID)
+	d.Set("name", zoneName)
+
+	// the current settings are both the initial settings, restored when the
+	// resource is destroyed, and the overridden settings
+	if err := d.Set("initial_settings", flattenZoneSettings(d, zoneSettings.Result, true)); err != nil {
+		log.Printf("[WARN] Error setting initial_settings for zone %q: %s", d.Id(), err)
+	}
+	d.Set("initial_settings_read_at", time.Now().UTC().Format(time.RFC3339Nano))
+
+	var editableSettings []cloudflare.ZoneSetting
+	for _, setting := range zoneSettings.Result {
+		if setting.Editable {
+			editableSettings = append(editableSettings, setting)
+		}
+	}
+	if err := d.Set("settings", flattenZoneSettings(d, editableSettings, true)); err != nil {
+		log.Printf("[WARN] Error setting settings for zone %q: %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func flattenZoneSettings(d *schema.ResourceData, settings []cloudflare.ZoneSetting, flattenAll bool) []map[string]interface{} {
 	cfg := map[string]interface{}{}
 	for _, s := range settings {
-		if !settingInSchema(s.ID) {
+		k := zoneSettingSchemaKey(s.ID)
+		if !settingInSchema(k) {
 			log.Printf("[WARN] Value not in schema returned from API zone settings (is it new?) - %q : %#v", s.ID, s.Value)
 			continue
 		}
-		if _, ok := d.GetOkExists(fmt.Sprintf("settings.0.%s", s.ID)); !ok && !flattenAll {
+		if _, ok := d.GetOkExists(fmt.Sprintf("settings.0.%s", k)); !ok && !flattenAll {
 			// don't put settings that were never specified in the update request
 			continue
 		}
 
-		if s.ID == "minify" || s.ID == "mobile_redirect" {
-			cfg[s.ID] = []interface{}{s.Value.(map[string]interface{})}
-		} else if s.ID == "security_header" {
-			cfg[s.ID] = []interface{}{s.Value.(map[string]interface{})["strict_transport_security"]}
-		} else if strValue, ok := s.Value.(string); ok {
-			cfg[s.ID] = strValue
-		} else if floatValue, ok := s.Value.(float64); ok {
-			cfg[s.ID] = int(floatValue)
+		if value, ok := flattenZoneSettingValue(k, s.Value); ok {
+			cfg[k] = value
 		} else {
 			log.Printf("[WARN] Unexpected value type found in API zone settings - %q : %#v", s.ID, s.Value)
 		}
@@ -574,6 +913,32 @@ func flattenZoneSettings(d *schema.ResourceData, settings []cloudflare.ZoneSetti
 	return []map[string]interface{}{cfg}
 }
 
+// flattenZoneSettingValue converts the value of the setting k returned by
+// the API to the type of its schema. Object values are nested blocks.
+func flattenZoneSettingValue(k string, value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if k == "security_header" {
+			return []interface{}{v["strict_transport_security"]}, true
+		}
+		return []interface{}{v}, true
+	case []interface{}:
+		return v, true
+	case string:
+		if resourceCloudflareZoneSettingsSchema[k].Type == schema.TypeInt {
+			i, err := strconv.Atoi(v)
+			return i, err == nil
+		}
+		return v, true
+	case float64:
+		if resourceCloudflareZoneSettingsSchema[k].Type == schema.TypeString {
+			return strconv.FormatFloat(v, 'f', -1, 64), true
+		}
+		return int(v), true
+	}
+	return nil, false
+}
+
 func settingInSchema(val string) bool {
 	for k, _ := range resourceCloudflareZoneSettingsSchema {
 		if val == k {
@@ -596,7 +961,8 @@ func flattenReadOnlyZoneSettings(settings []cloudflare.ZoneSetting) []string {
 }
 
 func resourceCloudflareZoneSettingsOverrideUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
 
@@ -639,7 +1005,7 @@ func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, re
 
 			if zoneSettingValue != nil {
 				newZoneSetting := cloudflare.ZoneSetting{
-					ID:    k,
+					ID:    zoneSettingAPIID(k),
 					Value: zoneSettingValue,
 				}
 				zoneSettings = append(zoneSettings, newZoneSetting)
@@ -653,7 +1019,12 @@ func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, re
 
 func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settingValue interface{}, readOnlySettings []string) (interface{}, error) {
 
-	if contains(readOnlySettings, k) {
+	if contains(readOnlySettings, zoneSettingAPIID(k)) {
+		if _, ok := zoneSettingPlans[k]; ok && !zoneSettingTurnsOn(k, settingValue) {
+			// the feature isn't available on the zone's plan, so it's off already
+			log.Printf("[DEBUG] Skipping read only zone setting %q, which is off", k)
+			return nil, nil
+		}
 		return nil, fmt.Errorf("invalid zone setting %q (value: %v) found - cannot be set as it is read only", k, settingValue)
 	}
 
@@ -669,7 +1040,7 @@ func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settin
 				zoneSettingValue = settingValue
 			}
 		}
-	case "minify", "mobile_redirect":
+	case "minify", "mobile_redirect", "nel", "automatic_platform_optimization":
 		{
 			listValue := settingValue.([]interface{})
 			if len(listValue) > 0 && listValue != nil {
@@ -695,7 +1066,8 @@ func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settin
 }
 
 func resourceCloudflareZoneSettingsOverrideDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
 
@@ -710,7 +1082,7 @@ func resourceCloudflareZoneSettingsOverrideDelete(d *schema.ResourceData, meta i
 
 		if len(zoneSettings) > 0 {
 			_, err = client.UpdateZoneSettings(d.Id(), zoneSettings)
-			if err != nil {
+			if err != nil && !isNotFound(err) {
 				return err
 			}
 		} else {
@@ -741,7 +1113,7 @@ func expandRevertibleZoneSettings(d *schema.ResourceData, readOnlySettings []str
 
 			if zoneSettingValue != nil {
 				newZoneSetting := cloudflare.ZoneSetting{
-					ID:    k,
+					ID:    zoneSettingAPIID(k),
 					Value: zoneSettingValue,
 				}
 				zoneSettings = append(zoneSettings, newZoneSetting)
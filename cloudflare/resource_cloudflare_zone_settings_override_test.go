@@ -1,13 +1,17 @@
 package cloudflare
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-cloudflare/internal/fakeapi"
 	"reflect"
 	"strings"
 )
@@ -55,6 +59,16 @@ func TestAccCloudflareZoneSettingsOverride_Full(t *testing.T) {
 						name, "settings.0.challenge_ttl", "2700"),
 					resource.TestCheckResourceAttr(
 						name, "settings.0.security_level", "high"),
+					resource.TestCheckResourceAttr(
+						name, "settings.0.zero_rtt", "on"),
+					resource.TestCheckResourceAttr(
+						name, "settings.0.http3", "on"),
+					resource.TestCheckResourceAttr(
+						name, "settings.0.nel.0.enabled", "true"),
+					resource.TestCheckResourceAttr(
+						name, "settings.0.ciphers.#", "2"),
+					resource.TestCheckResourceAttr(
+						name, "settings.0.ciphers.1", "ECDHE-RSA-AES128-GCM-SHA256"),
 				),
 			},
 		},
@@ -62,6 +76,201 @@ func TestAccCloudflareZoneSettingsOverride_Full(t *testing.T) {
 	})
 }
 
+// TestAccCloudflareZoneSettingsOverride_AllSettingsInSchema fails when the API
+// returns a zone setting the schema doesn't support, which would otherwise be
+// dropped from initial_settings with only a warning.
+func TestAccCloudflareZoneSettingsOverride_AllSettingsInSchema(t *testing.T) {
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigEmpty(zoneName),
+				Check: func(s *terraform.State) error {
+					client := testAccProvider.Meta().(*providerClient)
+					zoneID, err := client.ZoneIDByName(zoneName)
+					if err != nil {
+						return err
+					}
+					zoneSettings, err := client.ZoneSettings(zoneID)
+					if err != nil {
+						return err
+					}
+					return testAccCheckZoneSettingsInSchema(t, zoneSettings.Result)
+				},
+			},
+		},
+	})
+}
+
+func TestZoneSettingsSchemaCoversFakeAPI(t *testing.T) {
+	// round trip the settings through JSON, as they are when read from the API
+	var settings []cloudflare.ZoneSetting
+	for id, value := range fakeapi.DefaultZoneSettings {
+		settings = append(settings, cloudflare.ZoneSetting{ID: id, Value: value, Editable: true})
+	}
+	b, err := json.Marshal(settings)
+	if err == nil {
+		settings = nil
+		err = json.Unmarshal(b, &settings)
+	}
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := testAccCheckZoneSettingsInSchema(t, settings); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestZoneSettingsProxyReadTimeoutRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSettingsOverride().Schema, map[string]interface{}{
+		"name": "example.com",
+		"settings": []interface{}{
+			map[string]interface{}{"proxy_read_timeout": 200},
+		},
+	})
+
+	settings, err := expandOverriddenZoneSettings(d, "settings", nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(settings) != 1 || settings[0].ID != "proxy_read_timeout" || settings[0].Value != 200 {
+		t.Fatalf("expected proxy_read_timeout to be sent as 200, got %#v", settings)
+	}
+
+	// the API returns the value as a JSON number
+	b, err := json.Marshal(settings)
+	if err == nil {
+		settings = nil
+		err = json.Unmarshal(b, &settings)
+	}
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := d.Set("settings", flattenZoneSettings(d, settings, true)); err != nil {
+		t.Fatalf("error setting settings: %s", err)
+	}
+	if v := d.Get("settings.0.proxy_read_timeout"); v != 200 {
+		t.Fatalf("expected proxy_read_timeout to be read back as 200, got %#v", v)
+	}
+
+	if v, ok := flattenZoneSettingValue("proxy_read_timeout", "100"); !ok || v != 100 {
+		t.Fatalf("expected a string proxy_read_timeout of \"100\" to be read as 100, got %#v", v)
+	}
+}
+
+// testAccCheckZoneSettingsInSchema checks that every setting is in the schema
+// and that its value can be stored in state.
+func testAccCheckZoneSettingsInSchema(t *testing.T, settings []cloudflare.ZoneSetting) error {
+	for _, setting := range settings {
+		k := zoneSettingSchemaKey(setting.ID)
+		if !settingInSchema(k) {
+			return fmt.Errorf("zone setting %q returned by the API is not in the schema", setting.ID)
+		}
+		if zoneSettingAPIID(k) != setting.ID {
+			return fmt.Errorf("zone setting %q maps to %q and back to %q", setting.ID, k, zoneSettingAPIID(k))
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSettingsOverride().Schema, map[string]interface{}{})
+	flattened := flattenZoneSettings(d, settings, true)
+	for _, setting := range settings {
+		if _, ok := flattened[0][zoneSettingSchemaKey(setting.ID)]; !ok {
+			return fmt.Errorf("zone setting %q with value %#v could not be flattened", setting.ID, setting.Value)
+		}
+	}
+	if err := d.Set("initial_settings", flattened); err != nil {
+		return fmt.Errorf("error setting initial_settings: %s", err)
+	}
+
+	return nil
+}
+
+func TestAccCloudflareZoneSettingsOverride_PlanValidation(t *testing.T) {
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	enterpriseZoneName := "tf-acctest-settings-plan.example.net"
+	name := "cloudflare_zone_settings_override.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareZoneSettingsOverrideConfigSettings(zoneName, `polish = "lossless"`),
+				ExpectError: regexp.MustCompile(`polish requires the pro plan or higher`),
+			},
+			{
+				Config:      testAccCheckCloudflareZoneSettingsOverrideConfigSettings(zoneName, `browser_cache_ttl = 30`),
+				ExpectError: regexp.MustCompile(`browser_cache_ttl below 1800 seconds.* requires the enterprise plan`),
+			},
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigSettings(zoneName, `polish = "off"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.polish", "off"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigEnterprise(enterpriseZoneName, ""),
+			},
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigEnterprise(enterpriseZoneName, `
+resource "cloudflare_zone_settings_override" "test" {
+  name = "${cloudflare_zone.test.zone}"
+
+  settings {
+    polish            = "lossless"
+    browser_cache_ttl = 30
+    security_level    = "off"
+  }
+}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.polish", "lossless"),
+					resource.TestCheckResourceAttr(name, "settings.0.browser_cache_ttl", "30"),
+					resource.TestCheckResourceAttr(name, "settings.0.security_level", "off"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateZoneSettingForPlan(t *testing.T) {
+	cases := []struct {
+		setting string
+		value   interface{}
+		plan    string
+		valid   bool
+	}{
+		{"polish", "lossy", planIDFree, false},
+		{"polish", "lossy", planIDPro, true},
+		{"image_resizing", "on", planIDPro, false},
+		{"image_resizing", "on", planIDBusiness, true},
+		{"polish", "off", planIDFree, true},
+		{"image_resizing", "off", planIDFree, true},
+		{"proxy_read_timeout", 200, planIDBusiness, false},
+		{"proxy_read_timeout", 200, planIDEnterprise, true},
+		{"proxy_read_timeout", 100, planIDBusiness, true},
+		{"origin_max_http_version", "1", planIDBusiness, false},
+		{"origin_max_http_version", "2", planIDBusiness, true},
+		{"browser_cache_ttl", 30, planIDBusiness, false},
+		{"browser_cache_ttl", 30, planIDEnterprise, true},
+		{"browser_cache_ttl", 1800, planIDFree, true},
+		{"security_level", "off", planIDPro, false},
+		{"security_level", "high", planIDFree, true},
+		{"brotli", "on", planIDFree, true},
+	}
+	for _, c := range cases {
+		err := validateZoneSettingForPlan(c.setting, c.value, c.plan)
+		if c.valid && err != nil {
+			t.Errorf("%s = %v on the %s plan: unexpected error: %s", c.setting, c.value, c.plan, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("%s = %v on the %s plan: expected an error", c.setting, c.value, c.plan)
+		}
+	}
+}
+
 func TestAccCloudflareZoneSettingsOverride_RemoveAttributes(t *testing.T) {
 	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
 	name := "cloudflare_zone_settings_override.test"
@@ -131,7 +340,7 @@ func testAccCheckCloudflareZoneSettings(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Zone ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundZone, err := client.ZoneSettings(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -161,7 +370,7 @@ func testAccCheckCloudflareZoneSettings(n string) resource.TestCheckFunc {
 
 func testAccGetInitialZoneSettings(t *testing.T, zoneName string, settings map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 
 		zoneID, err := client.ZoneIDByName(zoneName)
 		if err != nil {
@@ -185,7 +394,7 @@ func testAccGetInitialZoneSettings(t *testing.T, zoneName string, settings map[s
 
 func testAccCheckInitialZoneSettings(zoneName string, initialSettings map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 
 		zoneID, err := client.ZoneIDByName(zoneName)
 		if err != nil {
@@ -216,6 +425,25 @@ resource "cloudflare_zone_settings_override" "test" {
 }`, zone)
 }
 
+func testAccCheckCloudflareZoneSettingsOverrideConfigSettings(zone, settings string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_settings_override" "test" {
+	name = "%s"
+	settings {
+		%s
+	}
+}`, zone, settings)
+}
+
+func testAccCheckCloudflareZoneSettingsOverrideConfigEnterprise(zone, override string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone" "test" {
+  zone = "%s"
+  plan = "enterprise"
+}
+%s`, zone, override)
+}
+
 func testAccCheckCloudflareZoneSettingsOverrideConfigNormal(zone string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_zone_settings_override" "test" {
@@ -234,6 +462,12 @@ resource "cloudflare_zone_settings_override" "test" {
 		security_header {
 			enabled = true
 		}
+		zero_rtt = "on"
+		http3 = "on"
+		nel {
+			enabled = true
+		}
+		ciphers = ["ECDHE-ECDSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256"]
 	}
 }`, zone)
 }
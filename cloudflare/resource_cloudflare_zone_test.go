@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -21,6 +22,8 @@ func TestZone(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "paused", "true"),
 					resource.TestCheckResourceAttr(name, "name_servers.#", "2"),
 					resource.TestCheckResourceAttr(name, "plan", planIDFree),
+					resource.TestCheckResourceAttr(name, "type", zoneTypeFull),
+					resource.TestCheckResourceAttr(name, "verification_key", ""),
 				),
 			},
 			{
@@ -36,6 +39,37 @@ func TestZone(t *testing.T) {
 	})
 }
 
+func TestZonePartial(t *testing.T) {
+	name := "cloudflare_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testZoneConfigWithType("test", "partial.example.org", zoneTypePartial),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone", "partial.example.org"),
+					resource.TestCheckResourceAttr(name, "type", zoneTypePartial),
+					resource.TestCheckResourceAttr(name, "status", zoneStatusPending),
+					resource.TestMatchResourceAttr(name, "verification_key", regexp.MustCompile(`^\S+$`)),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"jump_start"},
+			},
+			{
+				// the type of an imported zone is kept when it isn't configured
+				Config:   testZoneConfig("test", "partial.example.org", "false", "false"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testZoneConfig(resourceID, zoneName, paused, jumpStart string) string {
 	return fmt.Sprintf(`
 				resource "cloudflare_zone" "%[1]s" {
@@ -55,6 +89,14 @@ func testZoneConfigWithPlan(resourceID, zoneName, paused, jumpStart, plan string
 				}`, resourceID, zoneName, paused, jumpStart, plan)
 }
 
+func testZoneConfigWithType(resourceID, zoneName, zoneType string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone" "%[1]s" {
+  zone = "%[2]s"
+  type = "%[3]s"
+}`, resourceID, zoneName, zoneType)
+}
+
 func TestPlanNameFallsBackToEmptyIfUnknown(t *testing.T) {
 	type args struct {
 		planName string
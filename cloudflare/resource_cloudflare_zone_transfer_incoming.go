@@ -0,0 +1,201 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Directions of the zone transfers configured for a zone.
+const (
+	zoneTransferIncoming = "incoming"
+	zoneTransferOutgoing = "outgoing"
+)
+
+// zoneTransfer is the incoming or outgoing zone transfer configuration of a
+// zone, which the SDK doesn't support yet.
+type zoneTransfer struct {
+	ID                  string   `json:"id,omitempty"`
+	Name                string   `json:"name"`
+	Peers               []string `json:"peers"`
+	AutoRefreshSeconds  int      `json:"auto_refresh_seconds,omitempty"`
+	SOASerial           int      `json:"soa_serial,omitempty"`
+	CheckedTime         string   `json:"checked_time,omitempty"`
+	LastTransferredTime string   `json:"last_transferred_time,omitempty"`
+}
+
+func resourceCloudflareZoneTransferIncoming() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareZoneTransferIncomingCreate,
+		Read:   resourceCloudflareZoneTransferIncomingRead,
+		Update: resourceCloudflareZoneTransferIncomingUpdate,
+		Delete: resourceCloudflareZoneTransferIncomingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"peers": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"auto_refresh_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(300),
+			},
+			"soa_serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"checked_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneTransferIncomingCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	zoneID := d.Get("zone_id").(string)
+
+	transfer, err := expandZoneTransfer(d, client)
+	if err != nil {
+		return err
+	}
+	transfer.AutoRefreshSeconds = d.Get("auto_refresh_seconds").(int)
+
+	if err := setZoneTransfer(client, "POST", zoneID, zoneTransferIncoming, transfer); err != nil {
+		return err
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareZoneTransferIncomingRead(d, meta)
+}
+
+func resourceCloudflareZoneTransferIncomingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient)
+
+	transfer, err := getZoneTransfer(client, d.Id(), zoneTransferIncoming)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Removing incoming zone transfers from state because they are not configured for zone %q in API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading incoming zone transfers of zone %q: %s", d.Id(), err)
+	}
+
+	d.Set("zone_id", d.Id())
+	d.Set("name", transfer.Name)
+	d.Set("peers", transfer.Peers)
+	d.Set("auto_refresh_seconds", transfer.AutoRefreshSeconds)
+	d.Set("soa_serial", transfer.SOASerial)
+	d.Set("checked_time", transfer.CheckedTime)
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferIncomingUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	transfer, err := expandZoneTransfer(d, client)
+	if err != nil {
+		return err
+	}
+	transfer.AutoRefreshSeconds = d.Get("auto_refresh_seconds").(int)
+
+	if err := setZoneTransfer(client, "PUT", d.Id(), zoneTransferIncoming, transfer); err != nil {
+		return err
+	}
+
+	return resourceCloudflareZoneTransferIncomingRead(d, meta)
+}
+
+func resourceCloudflareZoneTransferIncomingDelete(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	return deleteZoneTransfer(client, d.Id(), zoneTransferIncoming)
+}
+
+// expandZoneTransfer returns the zone transfer configuration of the resource.
+// Transfers are named after the zone unless the name is set.
+func expandZoneTransfer(d *schema.ResourceData, client *providerClient) (zoneTransfer, error) {
+	transfer := zoneTransfer{
+		Name:  d.Get("name").(string),
+		Peers: expandInterfaceToStringList(d.Get("peers").(*schema.Set).List()),
+	}
+
+	if transfer.Name == "" {
+		zone, err := client.ZoneDetails(d.Get("zone_id").(string))
+		if err != nil {
+			return transfer, fmt.Errorf("Error finding zone %q: %s", d.Get("zone_id").(string), err)
+		}
+		transfer.Name = zone.Name
+	}
+
+	return transfer, nil
+}
+
+func getZoneTransfer(client *providerClient, zoneID, direction string) (zoneTransfer, error) {
+	var transfer zoneTransfer
+
+	res, err := client.Raw("GET", "/zones/"+zoneID+"/secondary_dns/"+direction, nil)
+	if err != nil {
+		return transfer, err
+	}
+
+	err = json.Unmarshal(res, &transfer)
+	return transfer, err
+}
+
+// setZoneTransfer creates, with POST, or replaces, with PUT, the zone
+// transfer configuration of a zone.
+func setZoneTransfer(client *providerClient, method, zoneID, direction string, transfer zoneTransfer) error {
+	log.Printf("[INFO] Setting %s zone transfers of zone %q: %#v", direction, zoneID, transfer)
+
+	if _, err := client.Raw(method, "/zones/"+zoneID+"/secondary_dns/"+direction, transfer); err != nil {
+		return fmt.Errorf("Error setting %s zone transfers of zone %q: %s", direction, zoneID, err)
+	}
+
+	return nil
+}
+
+func deleteZoneTransfer(client *providerClient, zoneID, direction string) error {
+	log.Printf("[INFO] Deleting %s zone transfers of zone %q", direction, zoneID)
+
+	_, err := client.Raw("DELETE", "/zones/"+zoneID+"/secondary_dns/"+direction, nil)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("Error deleting %s zone transfers of zone %q: %s", direction, zoneID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareZoneTransferIncoming_Basic(t *testing.T) {
+	zoneName := "tf-acctest-zone-transfer-in.example.net"
+	name := "cloudflare_zone_transfer_incoming.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneTransferIncomingConfig(zoneName, `"${cloudflare_zone_transfer_peer.primary.id}"`, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneTransferExists(name, zoneTransferIncoming),
+					resource.TestCheckResourceAttrPair(name, "zone_id", "cloudflare_zone.test", "id"),
+					resource.TestCheckResourceAttr(name, "name", zoneName),
+					resource.TestCheckResourceAttr(name, "peers.#", "1"),
+					resource.TestCheckResourceAttr(name, "auto_refresh_seconds", "86400"),
+					resource.TestCheckResourceAttrSet(name, "soa_serial"),
+					resource.TestCheckResourceAttrSet(name, "checked_time"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneTransferIncomingConfig(zoneName, `"${cloudflare_zone_transfer_peer.primary.id}", "${cloudflare_zone_transfer_peer.secondary.id}"`, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "peers.#", "2"),
+					resource.TestCheckResourceAttr(name, "auto_refresh_seconds", "3600"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneTransferExists(n, direction string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		_, err := getZoneTransfer(client, rs.Primary.ID, direction)
+		return err
+	}
+}
+
+func testAccCheckCloudflareZoneTransferDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		direction := map[string]string{
+			"cloudflare_zone_transfer_incoming": zoneTransferIncoming,
+			"cloudflare_zone_transfer_outgoing": zoneTransferOutgoing,
+		}[rs.Type]
+		if direction == "" {
+			continue
+		}
+
+		_, err := getZoneTransfer(client, rs.Primary.ID, direction)
+		if err == nil {
+			return fmt.Errorf("%s zone transfers of zone %s still exist", direction, rs.Primary.ID)
+		}
+		if !isNotFound(err) {
+			return err
+		}
+	}
+
+	return testAccCheckCloudflareZoneTransferPeerDestroy(s)
+}
+
+func testAccCloudflareZoneTransferIncomingConfig(zoneName, peers string, refresh int) string {
+	return testAccCloudflareZoneTransferPeersConfig(zoneName) + fmt.Sprintf(`
+resource "cloudflare_zone_transfer_incoming" "test" {
+  zone_id              = "${cloudflare_zone.test.id}"
+  peers                = [%[1]s]
+  auto_refresh_seconds = %[2]d
+}`, peers, refresh)
+}
+
+func testAccCloudflareZoneTransferPeersConfig(zoneName string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone" "test" {
+  zone = "%[1]s"
+}
+
+resource "cloudflare_zone_transfer_peer" "primary" {
+  name = "%[1]s-primary"
+  ip   = "192.0.2.53"
+}
+
+resource "cloudflare_zone_transfer_peer" "secondary" {
+  name = "%[1]s-secondary"
+  ip   = "192.0.2.54"
+}`, zoneName)
+}
@@ -0,0 +1,165 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCloudflareZoneTransferOutgoing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareZoneTransferOutgoingCreate,
+		Read:   resourceCloudflareZoneTransferOutgoingRead,
+		Update: resourceCloudflareZoneTransferOutgoingUpdate,
+		Delete: resourceCloudflareZoneTransferOutgoingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"peers": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"soa_serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_transferred_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneTransferOutgoingCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	zoneID := d.Get("zone_id").(string)
+
+	transfer, err := expandZoneTransfer(d, client)
+	if err != nil {
+		return err
+	}
+
+	if err := setZoneTransfer(client, "POST", zoneID, zoneTransferOutgoing, transfer); err != nil {
+		return err
+	}
+
+	d.SetId(zoneID)
+
+	if d.Get("enabled").(bool) {
+		if err := setZoneTransferOutgoingEnabled(client, zoneID, true); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudflareZoneTransferOutgoingRead(d, meta)
+}
+
+func resourceCloudflareZoneTransferOutgoingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient)
+
+	transfer, err := getZoneTransfer(client, d.Id(), zoneTransferOutgoing)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Removing outgoing zone transfers from state because they are not configured for zone %q in API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading outgoing zone transfers of zone %q: %s", d.Id(), err)
+	}
+
+	var status string
+	res, err := client.Raw("GET", "/zones/"+d.Id()+"/secondary_dns/outgoing/status", nil)
+	if err == nil {
+		err = json.Unmarshal(res, &status)
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading the status of outgoing zone transfers of zone %q: %s", d.Id(), err)
+	}
+
+	d.Set("zone_id", d.Id())
+	d.Set("name", transfer.Name)
+	d.Set("peers", transfer.Peers)
+	d.Set("enabled", status == "Enabled")
+	d.Set("soa_serial", transfer.SOASerial)
+	d.Set("last_transferred_time", transfer.LastTransferredTime)
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferOutgoingUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if d.HasChange("name") || d.HasChange("peers") {
+		transfer, err := expandZoneTransfer(d, client)
+		if err != nil {
+			return err
+		}
+
+		if err := setZoneTransfer(client, "PUT", d.Id(), zoneTransferOutgoing, transfer); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := setZoneTransferOutgoingEnabled(client, d.Id(), d.Get("enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudflareZoneTransferOutgoingRead(d, meta)
+}
+
+func resourceCloudflareZoneTransferOutgoingDelete(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	return deleteZoneTransfer(client, d.Id(), zoneTransferOutgoing)
+}
+
+// setZoneTransferOutgoingEnabled enables or disables the outgoing zone
+// transfers of a zone. They start out disabled once configured.
+func setZoneTransferOutgoingEnabled(client *providerClient, zoneID string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	log.Printf("[INFO] Setting outgoing zone transfers of zone %q to %sd", zoneID, action)
+
+	if _, err := client.Raw("POST", "/zones/"+zoneID+"/secondary_dns/outgoing/"+action, nil); err != nil {
+		return fmt.Errorf("Error trying to %s outgoing zone transfers of zone %q: %s", action, zoneID, err)
+	}
+
+	return nil
+}
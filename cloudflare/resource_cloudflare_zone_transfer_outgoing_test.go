@@ -0,0 +1,52 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCloudflareZoneTransferOutgoing_Basic(t *testing.T) {
+	zoneName := "tf-acctest-zone-transfer-out.example.net"
+	name := "cloudflare_zone_transfer_outgoing.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneTransferOutgoingConfig(zoneName, "custom-name", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneTransferExists(name, zoneTransferOutgoing),
+					resource.TestCheckResourceAttrPair(name, "zone_id", "cloudflare_zone.test", "id"),
+					resource.TestCheckResourceAttr(name, "name", "custom-name"),
+					resource.TestCheckResourceAttr(name, "peers.#", "2"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneTransferOutgoingConfig(zoneName, "custom-name", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneTransferOutgoingConfig(zoneName, transferName string, enabled bool) string {
+	return testAccCloudflareZoneTransferPeersConfig(zoneName) + fmt.Sprintf(`
+resource "cloudflare_zone_transfer_outgoing" "test" {
+  zone_id = "${cloudflare_zone.test.id}"
+  name    = "%[1]s"
+  peers   = ["${cloudflare_zone_transfer_peer.primary.id}", "${cloudflare_zone_transfer_peer.secondary.id}"]
+  enabled = %[2]t
+}`, transferName, enabled)
+}
@@ -0,0 +1,181 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// zoneTransferPeer is a nameserver zone transfers are made with, which the SDK
+// doesn't support yet.
+type zoneTransferPeer struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	IP         string `json:"ip,omitempty"`
+	Port       int    `json:"port,omitempty"`
+	IXFREnable bool   `json:"ixfr_enable"`
+	TSIGID     string `json:"tsig_id,omitempty"`
+}
+
+func resourceCloudflareZoneTransferPeer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareZoneTransferPeerCreate,
+		Read:   resourceCloudflareZoneTransferPeerRead,
+		Update: resourceCloudflareZoneTransferPeerUpdate,
+		Delete: resourceCloudflareZoneTransferPeerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareAccountScopedImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringIP,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      53,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"ixfr_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tsig_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneTransferPeerCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	path, err := secondaryDNSAccountPath(client, "peers")
+	if err != nil {
+		return err
+	}
+
+	peer := expandZoneTransferPeer(d)
+	log.Printf("[INFO] Creating Cloudflare zone transfer peer %q", peer.Name)
+
+	res, err := client.Raw("POST", path, peer)
+	if err != nil {
+		return fmt.Errorf("Error creating zone transfer peer %q: %s", peer.Name, err)
+	}
+	if err := json.Unmarshal(res, &peer); err != nil {
+		return fmt.Errorf("Error decoding zone transfer peer %q: %s", peer.Name, err)
+	}
+	if peer.ID == "" {
+		return fmt.Errorf("failed to find id in create response; resource was empty")
+	}
+
+	d.SetId(peer.ID)
+
+	return resourceCloudflareZoneTransferPeerRead(d, meta)
+}
+
+func resourceCloudflareZoneTransferPeerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
+
+	path, err := secondaryDNSAccountPath(client, "peers")
+	if err != nil {
+		return err
+	}
+
+	var peer zoneTransferPeer
+	res, err := client.Raw("GET", path+"/"+d.Id(), nil)
+	if err == nil {
+		err = json.Unmarshal(res, &peer)
+	}
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Removing zone transfer peer %q from state because it's not present in API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading zone transfer peer %q: %s", d.Id(), err)
+	}
+
+	d.Set("account_id", client.OrganizationID)
+	d.Set("name", peer.Name)
+	d.Set("ip", peer.IP)
+	d.Set("port", peer.Port)
+	d.Set("ixfr_enable", peer.IXFREnable)
+	d.Set("tsig_id", peer.TSIGID)
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	path, err := secondaryDNSAccountPath(client, "peers")
+	if err != nil {
+		return err
+	}
+
+	peer := expandZoneTransferPeer(d)
+	log.Printf("[INFO] Updating Cloudflare zone transfer peer %q", d.Id())
+
+	if _, err := client.Raw("PUT", path+"/"+d.Id(), peer); err != nil {
+		return fmt.Errorf("Error updating zone transfer peer %q: %s", d.Id(), err)
+	}
+
+	return resourceCloudflareZoneTransferPeerRead(d, meta)
+}
+
+func resourceCloudflareZoneTransferPeerDelete(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	path, err := secondaryDNSAccountPath(client, "peers")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Cloudflare zone transfer peer %q", d.Id())
+
+	_, err = client.Raw("DELETE", path+"/"+d.Id(), nil)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("Error deleting zone transfer peer %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandZoneTransferPeer(d *schema.ResourceData) zoneTransferPeer {
+	return zoneTransferPeer{
+		Name:       d.Get("name").(string),
+		IP:         d.Get("ip").(string),
+		Port:       d.Get("port").(int),
+		IXFREnable: d.Get("ixfr_enable").(bool),
+		TSIGID:     d.Get("tsig_id").(string),
+	}
+}
@@ -0,0 +1,120 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareZoneTransferPeer_Basic(t *testing.T) {
+	t.Parallel()
+	rnd := acctest.RandString(10)
+	name := "cloudflare_zone_transfer_peer.test"
+	tsigName := "cloudflare_zone_transfer_tsig.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneTransferPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneTransferPeerConfig(rnd, "192.0.2.53", 53, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneTransferPeerExists(name, "peers"),
+					testAccCheckCloudflareZoneTransferPeerExists(tsigName, "tsigs"),
+					resource.TestCheckResourceAttr(name, "name", "tf-acctest-"+rnd),
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.53"),
+					resource.TestCheckResourceAttr(name, "port", "53"),
+					resource.TestCheckResourceAttr(name, "ixfr_enable", "false"),
+					resource.TestCheckResourceAttrPair(name, "tsig_id", tsigName, "id"),
+					resource.TestCheckResourceAttrSet(name, "account_id"),
+					resource.TestCheckResourceAttr(tsigName, "algo", "hmac-sha512."),
+					resource.TestCheckResourceAttrSet(tsigName, "secret"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneTransferPeerConfig(rnd, "2001:db8::53", 5353, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ip", "2001:db8::53"),
+					resource.TestCheckResourceAttr(name, "port", "5353"),
+					resource.TestCheckResourceAttr(name, "ixfr_enable", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      tsigName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneTransferPeerExists(n, kind string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*providerClient).withAccount(rs.Primary.Attributes["account_id"])
+		path, err := secondaryDNSAccountPath(client, kind)
+		if err != nil {
+			return err
+		}
+		_, err = client.Raw("GET", path+"/"+rs.Primary.ID, nil)
+		return err
+	}
+}
+
+func testAccCheckCloudflareZoneTransferPeerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		kind := map[string]string{
+			"cloudflare_zone_transfer_peer": "peers",
+			"cloudflare_zone_transfer_tsig": "tsigs",
+		}[rs.Type]
+		if kind == "" {
+			continue
+		}
+
+		path, err := secondaryDNSAccountPath(client.withAccount(rs.Primary.Attributes["account_id"]), kind)
+		if err != nil {
+			return err
+		}
+		_, err = client.Raw("GET", path+"/"+rs.Primary.ID, nil)
+		if err == nil {
+			return fmt.Errorf("%s %s still exists", rs.Type, rs.Primary.ID)
+		}
+		if !isNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudflareZoneTransferPeerConfig(rnd, ip string, port int, ixfr bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_transfer_tsig" "test" {
+  name   = "tf-acctest-%[1]s."
+  algo   = "hmac-sha512."
+  secret = "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c"
+}
+
+resource "cloudflare_zone_transfer_peer" "test" {
+  name        = "tf-acctest-%[1]s"
+  ip          = "%[2]s"
+  port        = %[3]d
+  ixfr_enable = %[4]t
+  tsig_id     = "${cloudflare_zone_transfer_tsig.test.id}"
+}`, rnd, ip, port, ixfr)
+}
@@ -0,0 +1,177 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// zoneTransferTSIG is a TSIG key authenticating zone transfers, which the SDK
+// doesn't support yet.
+type zoneTransferTSIG struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Algo   string `json:"algo"`
+	Secret string `json:"secret"`
+}
+
+func resourceCloudflareZoneTransferTSIG() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareZoneTransferTSIGCreate,
+		Read:   resourceCloudflareZoneTransferTSIGRead,
+		Update: resourceCloudflareZoneTransferTSIGUpdate,
+		Delete: resourceCloudflareZoneTransferTSIGDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareAccountScopedImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"algo": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"hmac-md5.sig-alg.reg.int.", "hmac-sha1.", "hmac-sha256.", "hmac-sha512.",
+				}, false),
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneTransferTSIGCreate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	path, err := secondaryDNSAccountPath(client, "tsigs")
+	if err != nil {
+		return err
+	}
+
+	tsig := expandZoneTransferTSIG(d)
+	log.Printf("[INFO] Creating Cloudflare zone transfer TSIG key %q", tsig.Name)
+
+	res, err := client.Raw("POST", path, tsig)
+	if err != nil {
+		return fmt.Errorf("Error creating zone transfer TSIG key %q: %s", tsig.Name, err)
+	}
+	if err := json.Unmarshal(res, &tsig); err != nil {
+		return fmt.Errorf("Error decoding zone transfer TSIG key %q: %s", tsig.Name, err)
+	}
+	if tsig.ID == "" {
+		return fmt.Errorf("failed to find id in create response; resource was empty")
+	}
+
+	d.SetId(tsig.ID)
+
+	return resourceCloudflareZoneTransferTSIGRead(d, meta)
+}
+
+func resourceCloudflareZoneTransferTSIGRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerClient).withAccount(d.Get("account_id").(string))
+
+	path, err := secondaryDNSAccountPath(client, "tsigs")
+	if err != nil {
+		return err
+	}
+
+	var tsig zoneTransferTSIG
+	res, err := client.Raw("GET", path+"/"+d.Id(), nil)
+	if err == nil {
+		err = json.Unmarshal(res, &tsig)
+	}
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Removing zone transfer TSIG key %q from state because it's not present in API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading zone transfer TSIG key %q: %s", d.Id(), err)
+	}
+
+	d.Set("account_id", client.OrganizationID)
+	d.Set("name", tsig.Name)
+	d.Set("algo", tsig.Algo)
+	d.Set("secret", tsig.Secret)
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferTSIGUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	path, err := secondaryDNSAccountPath(client, "tsigs")
+	if err != nil {
+		return err
+	}
+
+	tsig := expandZoneTransferTSIG(d)
+	log.Printf("[INFO] Updating Cloudflare zone transfer TSIG key %q", d.Id())
+
+	if _, err := client.Raw("PUT", path+"/"+d.Id(), tsig); err != nil {
+		return fmt.Errorf("Error updating zone transfer TSIG key %q: %s", d.Id(), err)
+	}
+
+	return resourceCloudflareZoneTransferTSIGRead(d, meta)
+}
+
+func resourceCloudflareZoneTransferTSIGDelete(d *schema.ResourceData, meta interface{}) error {
+	client, cancel := meta.(*providerClient).withAccount(d.Get("account_id").(string)).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	path, err := secondaryDNSAccountPath(client, "tsigs")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Cloudflare zone transfer TSIG key %q", d.Id())
+
+	_, err = client.Raw("DELETE", path+"/"+d.Id(), nil)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("Error deleting zone transfer TSIG key %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandZoneTransferTSIG(d *schema.ResourceData) zoneTransferTSIG {
+	return zoneTransferTSIG{
+		Name:   d.Get("name").(string),
+		Algo:   d.Get("algo").(string),
+		Secret: d.Get("secret").(string),
+	}
+}
+
+// secondaryDNSAccountPath returns the API path of the account's secondary DNS
+// objects of the given kind, i.e. peers or TSIG keys.
+func secondaryDNSAccountPath(client *providerClient, kind string) (string, error) {
+	if client.OrganizationID == "" {
+		return "", fmt.Errorf("account_id must be set on the resource or the provider to manage zone transfer %s", kind)
+	}
+
+	return "/accounts/" + client.OrganizationID + "/secondary_dns/" + kind, nil
+}
@@ -0,0 +1,303 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimitThreshold is the number of consecutive 429 responses after which
+// the request rate is halved.
+const rateLimitThreshold = 3
+
+// rateRecoverySteps is the number of successful responses it takes for a
+// reduced request rate to recover to the configured rate.
+const rateRecoverySteps = 20
+
+// minRate is the lowest request rate, per second, the transport slows down to
+// when the API keeps rate limiting it.
+const minRate = 0.1
+
+// retryTransport rate limits and retries API requests. It takes over from the
+// SDK's own rate limiting and retries, which ignore the request context and
+// so can't be interrupted.
+//
+// Retries are spaced by an exponential backoff with jitter, or by the
+// Retry-After header when the API sends one. When the API keeps responding
+// with 429s the request rate is reduced, so that several Terraform runs
+// sharing an account's rate limit back off from each other, and then slowly
+// raised back to the configured rate as requests succeed.
+type retryTransport struct {
+	base       http.RoundTripper
+	limiter    *rate.Limiter
+	rate       rate.Limit
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	// retryableErrorCodes are API error codes that are retried even though
+	// they come with a 4xx status.
+	retryableErrorCodes map[int]bool
+
+	mu          sync.Mutex
+	rateLimited int
+}
+
+func newRetryTransport(base http.RoundTripper, rps float64, maxRetries int, minBackoff, maxBackoff time.Duration, retryableErrorCodes []int) *retryTransport {
+	codes := make(map[int]bool)
+	for code := range rateLimitedErrorCodes {
+		codes[code] = true
+	}
+	for _, code := range retryableErrorCodes {
+		codes[code] = true
+	}
+
+	return &retryTransport{
+		base:                base,
+		limiter:             rate.NewLimiter(rate.Limit(rps), 1),
+		rate:                rate.Limit(rps),
+		maxRetries:          maxRetries,
+		minBackoff:          minBackoff,
+		maxBackoff:          maxBackoff,
+		retryableErrorCodes: codes,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var wait time.Duration
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			log.Printf("[DEBUG] Sleeping %s before retry attempt number %d for request %s %s", wait, attempt, req.Method, req.URL.Path)
+			if err := sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		r := req.WithContext(ctx)
+		if body != nil {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.base.RoundTrip(r)
+		if ctx.Err() != nil {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			return nil, ctx.Err()
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+			t.throttled()
+		} else if err == nil {
+			t.succeeded()
+		}
+
+		if attempt >= t.maxRetries || !t.shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		wait = t.backoff(attempt + 1)
+		if err != nil {
+			log.Printf("[DEBUG] Error performing request %s %s: %s", req.Method, req.URL.Path, err)
+		} else {
+			log.Printf("[DEBUG] Request %s %s got an error response %d", req.Method, req.URL.Path, resp.StatusCode)
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				// Spread out the requests that were all told to come back at the
+				// same time, but never wait longer than the maximum backoff.
+				wait = retryAfter + jitter(t.minBackoff)
+				if wait > t.maxBackoff {
+					wait = t.maxBackoff
+				}
+			}
+			// drain the body so that the connection can be reused
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+	}
+}
+
+// backoff returns how long to wait before the given retry attempt: a random
+// duration between half and all of a backoff that doubles with every attempt
+// up to the maximum backoff, and never less than the minimum backoff.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	backoff := time.Duration(math.Pow(2, float64(attempt-1)) * float64(t.minBackoff))
+	if backoff > t.maxBackoff || backoff < 0 {
+		backoff = t.maxBackoff
+	}
+	least := backoff / 2
+	if least < t.minBackoff {
+		least = t.minBackoff
+	}
+	if least > backoff {
+		least = backoff
+	}
+	return least + jitter(backoff-least)
+}
+
+// shouldRetry reports whether a request is worth retrying: the request
+// failed, the API is rate limiting us, the API failed or the API returned one
+// of the retryable error codes. It assumes the API rolls back failed
+// operations.
+func (t *retryTransport) shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return true
+	}
+	if resp.StatusCode < http.StatusBadRequest {
+		return false
+	}
+
+	for _, code := range responseErrorCodes(resp) {
+		if t.retryableErrorCodes[code] {
+			return true
+		}
+	}
+	return false
+}
+
+// throttled records a 429 response, halving the request rate after
+// rateLimitThreshold of them in a row.
+func (t *retryTransport) throttled() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rateLimited++
+	if t.rateLimited < rateLimitThreshold || t.rate == rate.Inf {
+		return
+	}
+	t.rateLimited = 0
+
+	limit := t.limiter.Limit() / 2
+	if limit < minRate {
+		limit = minRate
+	}
+	if limit < t.limiter.Limit() {
+		log.Printf("[WARN] Cloudflare API keeps rate limiting requests, slowing down to %.2f requests per second", float64(limit))
+		t.limiter.SetLimit(limit)
+	}
+}
+
+// succeeded records a response that wasn't a 429, raising a reduced request
+// rate back towards the configured rate.
+func (t *retryTransport) succeeded() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rateLimited = 0
+	if t.rate == rate.Inf || t.limiter.Limit() >= t.rate {
+		return
+	}
+
+	limit := t.limiter.Limit() + t.rate/rateRecoverySteps
+	if limit > t.rate {
+		limit = t.rate
+	}
+	t.limiter.SetLimit(limit)
+}
+
+// responseErrorCodes returns the error codes in an API response, leaving the
+// body to be read again.
+func responseErrorCodes(resp *http.Response) []int {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	var envelope struct {
+		Errors []apiErrorDetail `json:"errors"`
+	}
+	if json.Unmarshal(body, &envelope) != nil {
+		return nil
+	}
+
+	codes := make([]int, len(envelope.Errors))
+	for i, e := range envelope.Errors {
+		codes[i] = e.Code
+	}
+	return codes
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date, into how long to wait from now.
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// jitter returns a random duration between 0 and max.
+func jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
+
+// sleep waits for d to elapse, returning early if ctx is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// contextTransport sends every request with ctx. The SDK creates requests
+// without a context, so this is the only way to cancel them.
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
@@ -0,0 +1,317 @@
+package cloudflare
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+func TestRetryTransport_Retries(t *testing.T) {
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"www"}` {
+			t.Errorf("expected the request body to be replayed, got %q", body)
+		}
+		switch atomic.AddInt64(&requests, 1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Write([]byte(`{"success":true}`))
+		}
+	}))
+	defer server.Close()
+
+	transport := newRetryTransport(http.DefaultTransport, 100, 3, time.Millisecond, 10*time.Millisecond, nil)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"www"}`))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryTransport_GivesUp(t *testing.T) {
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	transport := newRetryTransport(http.DefaultTransport, 100, 2, time.Millisecond, 10*time.Millisecond, nil)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %d", resp.StatusCode)
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryTransport_CancelDuringBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	transport := newRetryTransport(http.DefaultTransport, 100, 3, time.Hour, time.Hour, nil)
+	client := &http.Client{Transport: transport}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	start := time.Now()
+	_, err := client.Do(req.WithContext(ctx))
+	if err == nil {
+		t.Fatal("expected the request to be cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the backoff to be interrupted, took %s", elapsed)
+	}
+}
+
+func TestRetryTransport_CancelRateLimitWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// one request every 100 seconds
+	transport := newRetryTransport(http.DefaultTransport, 0.01, 0, time.Second, time.Second, nil)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	start := time.Now()
+	if _, err := client.Do(req.WithContext(ctx)); err == nil {
+		t.Fatal("expected the request to be cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the rate limit wait to be interrupted, took %s", elapsed)
+	}
+}
+
+func TestProviderClient_WithTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	api, err := cloudflare.New("fake-api-key", "user@example.com", cloudflare.UsingRetryPolicy(0, 0, 0), usingBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client := newProviderClient(api, context.Background(), http.DefaultTransport)
+
+	timeoutClient, cancel := client.withTimeout(50 * time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := timeoutClient.ListZones(); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the request to be cancelled, took %s", elapsed)
+	}
+}
+
+func TestRetryTransport_RetryableErrorCodes(t *testing.T) {
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt64(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"success":false,"errors":[{"code":1234,"message":"Record is locked"}]}`))
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	transport := newRetryTransport(http.DefaultTransport, 100, 3, time.Millisecond, 10*time.Millisecond, []int{1234})
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || requests != 2 {
+		t.Fatalf("expected the locked record error to be retried, got status %d after %d requests", resp.StatusCode, requests)
+	}
+
+	atomic.StoreInt64(&requests, 0)
+	transport = newRetryTransport(http.DefaultTransport, 100, 3, time.Millisecond, 10*time.Millisecond, nil)
+	resp, err = (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest || requests != 1 {
+		t.Fatalf("expected the error not to be retried, got status %d after %d requests", resp.StatusCode, requests)
+	}
+	if body, _ := ioutil.ReadAll(resp.Body); !strings.Contains(string(body), "Record is locked") {
+		t.Fatalf("expected the response body to be readable, got %q", body)
+	}
+}
+
+func TestRetryTransport_RetryAfter(t *testing.T) {
+	cases := []struct {
+		retryAfter        string
+		maxBackoff        time.Duration
+		atLeast, lessThan time.Duration
+	}{
+		// the backoff alone would retry almost immediately
+		{"1", 2 * time.Second, time.Second, 2 * time.Second},
+		// but the wait is capped by the maximum backoff
+		{"3600", 10 * time.Millisecond, 0, time.Second},
+	}
+
+	for _, c := range cases {
+		var requests int64
+		var first, second time.Time
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt64(&requests, 1) == 1 {
+				first = time.Now()
+				w.Header().Set("Retry-After", c.retryAfter)
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			second = time.Now()
+		}))
+
+		transport := newRetryTransport(http.DefaultTransport, 100, 1, time.Millisecond, c.maxBackoff, nil)
+		resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+		server.Close()
+		if err != nil {
+			t.Fatalf("Retry-After %s: err: %s", c.retryAfter, err)
+		}
+		resp.Body.Close()
+
+		if requests != 2 {
+			t.Fatalf("Retry-After %s: expected 2 requests, got %d", c.retryAfter, requests)
+		}
+		if wait := second.Sub(first); wait < c.atLeast || wait >= c.lessThan {
+			t.Fatalf("Retry-After %s: expected the retry to wait between %s and %s, waited %s", c.retryAfter, c.atLeast, c.lessThan, wait)
+		}
+	}
+}
+
+func TestRetryTransport_AdaptiveRate(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 4, 3, time.Second, time.Second, nil)
+
+	for i := 0; i < rateLimitThreshold-1; i++ {
+		transport.throttled()
+	}
+	if limit := transport.limiter.Limit(); limit != 4 {
+		t.Fatalf("expected the rate to be unchanged, got %v", limit)
+	}
+
+	transport.throttled()
+	if limit := transport.limiter.Limit(); limit != 2 {
+		t.Fatalf("expected the rate to be halved, got %v", limit)
+	}
+
+	// a success resets the count of consecutive 429s
+	transport.succeeded()
+	for i := 0; i < rateLimitThreshold-1; i++ {
+		transport.throttled()
+	}
+	if limit := transport.limiter.Limit(); limit != 2.2 {
+		t.Fatalf("expected the rate to start recovering, got %v", limit)
+	}
+
+	for i := 0; i < 100; i++ {
+		transport.throttled()
+	}
+	if limit := transport.limiter.Limit(); limit != minRate {
+		t.Fatalf("expected the rate to be %v at the lowest, got %v", minRate, limit)
+	}
+
+	for i := 0; i < 100; i++ {
+		transport.succeeded()
+	}
+	if limit := transport.limiter.Limit(); limit != 4 {
+		t.Fatalf("expected the rate to recover to the configured rate, got %v", limit)
+	}
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 4, 10, time.Second, 30*time.Second, nil)
+
+	cases := []struct {
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{1, time.Second, time.Second},
+		{2, time.Second, 2 * time.Second},
+		{3, 2 * time.Second, 4 * time.Second},
+		{6, 15 * time.Second, 30 * time.Second},
+		{100, 15 * time.Second, 30 * time.Second},
+	}
+	for _, c := range cases {
+		for i := 0; i < 50; i++ {
+			if backoff := transport.backoff(c.attempt); backoff < c.min || backoff > c.max {
+				t.Fatalf("expected the backoff for attempt %d to be between %s and %s, got %s", c.attempt, c.min, c.max, backoff)
+			}
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		header string
+		wait   time.Duration
+		ok     bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"-1", 0, false},
+		{"Sat, 01 Jun 2019 12:00:30 GMT", 30 * time.Second, true},
+		{"Sat, 01 Jun 2019 11:59:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, c := range cases {
+		wait, ok := parseRetryAfter(c.header, now)
+		if wait != c.wait || ok != c.ok {
+			t.Errorf("%q: expected (%s, %t), got (%s, %t)", c.header, c.wait, c.ok, wait, ok)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package cloudflare
 
-import "github.com/hashicorp/terraform/helper/schema"
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
 
 func expandInterfaceToStringList(list interface{}) []string {
 	ifaceList := list.([]interface{})
@@ -37,3 +41,15 @@ func HashByMapKey(key string) func(v interface{}) int {
 		return schema.HashString(m[key])
 	}
 }
+
+// resourceCloudflareAccountScopedImport imports a resource that lives in an
+// account either by its ID, for one in the provider's account, or by
+// "accountID/ID".
+func resourceCloudflareAccountScopedImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if idAttr := strings.SplitN(d.Id(), "/", 2); len(idAttr) == 2 {
+		d.Set("account_id", idAttr[0])
+		d.SetId(idAttr[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
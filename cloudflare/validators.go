@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -29,10 +31,28 @@ func validateRecordType(t string, proxied bool) error {
 	return fmt.Errorf("Type %q cannot be proxied", t)
 }
 
+// validateRecordTypeName is a ValidateFunc for attributes naming a record
+// type, in any case.
+func validateRecordTypeName(v interface{}, k string) (ws []string, errors []error) {
+	if err := validateRecordType(strings.ToUpper(v.(string)), false); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
 // validateRecordName ensures that based on supplied record type, the name content matches
-// Currently only validates A and AAAA types
 func validateRecordName(t string, value string) error {
 	switch t {
+	case "CNAME", "MX":
+		// "." is the null MX of RFC 7505 and "@" the apex of the zone
+		if value == "." || value == "@" {
+			return nil
+		}
+		fallthrough
+	case "NS":
+		if err := validateRecordHostname(value); err != nil {
+			return fmt.Errorf("%s record must be a valid hostname: %s", t, err)
+		}
 	case "A":
 		// Must be ipv4 addr
 		addr := net.ParseIP(value)
@@ -58,6 +78,116 @@ func validateRecordName(t string, value string) error {
 	return nil
 }
 
+var recordHostnameLabel = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+
+// validateRecordHostname ensures that name is a syntactically valid hostname,
+// with or without the trailing dot. Labels may start with an underscore as is
+// common for the targets of service records.
+func validateRecordHostname(name string) error {
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" {
+		return fmt.Errorf("%q is empty", name)
+	}
+	if len(trimmed) > 253 {
+		return fmt.Errorf("%q is longer than 253 characters", name)
+	}
+	for _, label := range strings.Split(trimmed, ".") {
+		if !recordHostnameLabel.MatchString(label) {
+			return fmt.Errorf("%q has an invalid label %q", name, label)
+		}
+	}
+	return nil
+}
+
+func recordDataInt(min, max int) func(string) error {
+	return func(v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("must be a whole number, got %q", v)
+		}
+		if n < min || n > max {
+			return fmt.Errorf("must be between %d and %d, got %d", min, max, n)
+		}
+		return nil
+	}
+}
+
+func recordDataFloat(min, max float64) func(string) error {
+	return func(v string) error {
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("must be a number, got %q", v)
+		}
+		if n < min || n > max {
+			return fmt.Errorf("must be between %g and %g, got %g", min, max, n)
+		}
+		return nil
+	}
+}
+
+func recordDataOneOf(values ...string) func(string) error {
+	return func(v string) error {
+		if !contains(values, v) {
+			return fmt.Errorf("must be one of %q, got %q", values, v)
+		}
+		return nil
+	}
+}
+
+func recordDataHex(v string) error {
+	for _, c := range v {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return fmt.Errorf("must be hexadecimal, got %q", v)
+		}
+	}
+	return nil
+}
+
+// recordDataTarget checks the domain name a record points at, where "." means
+// that there is none.
+func recordDataTarget(v string) error {
+	if v == "." {
+		return nil
+	}
+	return validateRecordHostname(v)
+}
+
+func recordDataServiceLabel(v string) error {
+	if !strings.HasPrefix(v, "_") || strings.Contains(v, ".") {
+		return fmt.Errorf("must be a single label starting with an underscore, got %q", v)
+	}
+	return nil
+}
+
+// validateRecordData ensures that the data of a record has the keys its type
+// requires, with values in the ranges the API accepts. A nil value is one
+// that isn't known yet, which is only checked for being present.
+func validateRecordData(t string, data map[string]interface{}) error {
+	fields, ok := recordDataFields[t]
+	if !ok {
+		return fmt.Errorf("%s records are set with 'value'", t)
+	}
+
+	block := strings.ToLower(t)
+	for _, field := range fields {
+		v, ok := data[field.key]
+		if !ok {
+			if field.required {
+				return fmt.Errorf("%s records require %s.%s", t, block, field.key)
+			}
+			continue
+		}
+		if v == nil || field.validate == nil {
+			continue
+		}
+		if err := field.validate(fmt.Sprintf("%v", v)); err != nil {
+			return fmt.Errorf("%s.%s %s", block, field.key, err)
+		}
+	}
+
+	return nil
+}
+
 func validateStringIP(v interface{}, k string) (warnings []string, errors []error) {
 	ip := net.ParseIP(v.(string))
 	if ip == nil {
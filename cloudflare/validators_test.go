@@ -1,6 +1,9 @@
 package cloudflare
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestValidateRecordType(t *testing.T) {
 	validTypes := map[string]bool{
@@ -44,6 +47,7 @@ func TestValidateRecordName(t *testing.T) {
 		"A":    "192.168.0.1",
 		"AAAA": "2001:0db8:0000:0042:0000:8a2e:0370:7334",
 		"TXT":  " ",
+		"MX":   "mx.example.com",
 	}
 
 	for k, v := range validNames {
@@ -52,14 +56,92 @@ func TestValidateRecordName(t *testing.T) {
 		}
 	}
 
+	for _, k := range []string{"MX", "CNAME"} {
+		for _, v := range []string{".", "@"} {
+			if err := validateRecordName(k, v); err != nil {
+				t.Fatalf("%q should be a valid name for type %q: %v", v, k, err)
+			}
+		}
+	}
+
 	invalidNames := map[string]string{
 		"A":    "terraform.io",
 		"AAAA": "192.168.0.1",
 		"TXT":  "\n",
+		"MX":   "192.168.0.1:25",
 	}
 	for k, v := range invalidNames {
 		if err := validateRecordName(k, v); err == nil {
 			t.Fatalf("%q should be an invalid name for type %q", v, k)
 		}
 	}
+
+	for _, v := range []string{".", "@"} {
+		if err := validateRecordName("NS", v); err == nil {
+			t.Fatalf("%q should be an invalid name for type %q", v, "NS")
+		}
+	}
+}
+
+func TestValidateRecordHostname(t *testing.T) {
+	for _, name := range []string{"example.com", "example.com.", "_sip._udp.example.com", "a-b.example.com", "1.example.com"} {
+		if err := validateRecordHostname(name); err != nil {
+			t.Fatalf("%q should be a valid hostname: %s", name, err)
+		}
+	}
+
+	for _, name := range []string{"", ".", "-a.example.com", "a-.example.com", "a..example.com", "a b.example.com", "http://example.com"} {
+		if err := validateRecordHostname(name); err == nil {
+			t.Fatalf("%q should be an invalid hostname", name)
+		}
+	}
+}
+
+func TestValidateRecordData(t *testing.T) {
+	srv := func(key string, value interface{}) map[string]interface{} {
+		data := map[string]interface{}{
+			"service": "_sip", "proto": "_udp", "name": "example.com",
+			"priority": "10", "weight": "60", "port": "5060", "target": "sip.example.com",
+		}
+		if value == "" {
+			delete(data, key)
+		} else {
+			data[key] = value
+		}
+		return data
+	}
+
+	cases := []struct {
+		recordType string
+		data       map[string]interface{}
+		err        string
+	}{
+		{"SRV", srv("port", "5060"), ""},
+		{"SRV", srv("target", "."), ""},
+		{"SRV", srv("port", nil), ""},
+		{"SRV", srv("port", ""), "SRV records require srv.port"},
+		{"SRV", srv("port", "70000"), "srv.port must be between 0 and 65535, got 70000"},
+		{"SRV", srv("service", "sip"), "srv.service must be a single label starting with an underscore"},
+		{"SRV", srv("target", "sip..example.com"), `srv.target "sip..example.com" has an invalid label ""`},
+		{"A", srv("port", "5060"), "A records are set with 'value'"},
+		{"CAA", map[string]interface{}{"flags": "0", "tag": "issue", "value": "letsencrypt.org"}, ""},
+		{"CAA", map[string]interface{}{"flags": "0", "tag": "issuer", "value": "letsencrypt.org"}, "caa.tag must be one of"},
+		{"TLSA", map[string]interface{}{"usage": "3", "selector": "1", "matching_type": "1", "certificate": "0C72AC70"}, ""},
+		{"TLSA", map[string]interface{}{"usage": "3", "selector": "1", "matching_type": "1", "certificate": "not hex"}, "tlsa.certificate must be hexadecimal"},
+		{"SSHFP", map[string]interface{}{"algorithm": "4", "type": "two", "fingerprint": "1234"}, `sshfp.type must be a whole number, got "two"`},
+		{"LOC", map[string]interface{}{"lat_degrees": "37", "lat_direction": "N", "long_degrees": "122", "long_direction": "W", "altitude": "0.00"}, ""},
+		{"LOC", map[string]interface{}{"lat_degrees": "91", "lat_direction": "N", "long_degrees": "122", "long_direction": "W", "altitude": "0"}, "loc.lat_degrees must be between 0 and 90, got 91"},
+		{"LOC", map[string]interface{}{"lat_degrees": "37", "lat_direction": "E", "long_degrees": "122", "long_direction": "W", "altitude": "0"}, "loc.lat_direction must be one of"},
+		{"NAPTR", map[string]interface{}{"order": "100", "preference": "10", "flags": "S", "service": "SIP+D2U", "regex": "", "replacement": "_sip._udp.example.com"}, ""},
+		{"NAPTR", map[string]interface{}{"order": "100", "preference": "10"}, "NAPTR records require naptr.replacement"},
+	}
+
+	for _, c := range cases {
+		err := validateRecordData(c.recordType, c.data)
+		if c.err == "" && err != nil {
+			t.Errorf("%s %v: expected no error, got %s", c.recordType, c.data, err)
+		} else if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%s %v: expected error %q, got %v", c.recordType, c.data, c.err, err)
+		}
+	}
 }
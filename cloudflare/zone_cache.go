@@ -0,0 +1,188 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// zoneCachePageSize is the number of zones requested per page when filling
+// the zone cache, the maximum the API allows.
+const zoneCachePageSize = 50
+
+// zoneCache holds every zone visible to the provider so that resolving a zone
+// name to its ID doesn't cost an API call for every resource. It is filled
+// with a single paginated listing the first time it is used and is safe for
+// concurrent use.
+type zoneCache struct {
+	mu     sync.Mutex
+	loaded bool
+	zones  []cloudflare.Zone
+	ids    map[string][]string
+}
+
+func newZoneCache() *zoneCache {
+	return &zoneCache{
+		ids: make(map[string][]string),
+	}
+}
+
+// List returns all zones, filling the cache using client first if needed.
+func (c *zoneCache) List(client *cloudflare.API) ([]cloudflare.Zone, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(client); err != nil {
+		return nil, err
+	}
+
+	zones := make([]cloudflare.Zone, len(c.zones))
+	copy(zones, c.zones)
+	return zones, nil
+}
+
+// ZoneIDByName resolves zoneName to its ID. Zones the cache doesn't know
+// about, e.g. ones created outside of Terraform after the cache was filled,
+// are looked up individually and remembered.
+func (c *zoneCache) ZoneIDByName(client *cloudflare.API, zoneName string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(client); err != nil {
+		return "", err
+	}
+
+	switch ids := c.ids[zoneName]; len(ids) {
+	case 0:
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("ambiguous zone name used without an account ID")
+	}
+
+	log.Printf("[DEBUG] Zone %q not in zone cache, looking it up", zoneName)
+
+	zoneID, err := client.ZoneIDByName(zoneName)
+	if err != nil {
+		return "", err
+	}
+
+	c.ids[zoneName] = []string{zoneID}
+	return zoneID, nil
+}
+
+// Add records a zone created by the provider.
+func (c *zoneCache) Add(zone cloudflare.Zone) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		return
+	}
+
+	c.remove(zone.ID)
+	c.zones = append(c.zones, zone)
+	c.ids[zone.Name] = append(c.ids[zone.Name], zone.ID)
+}
+
+// Remove forgets a zone deleted by the provider.
+func (c *zoneCache) Remove(zoneID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(zoneID)
+}
+
+func (c *zoneCache) remove(zoneID string) {
+	for i, zone := range c.zones {
+		if zone.ID == zoneID {
+			c.zones = append(c.zones[:i], c.zones[i+1:]...)
+			break
+		}
+	}
+
+	for name, ids := range c.ids {
+		for i, id := range ids {
+			if id == zoneID {
+				ids = append(ids[:i], ids[i+1:]...)
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(c.ids, name)
+		} else {
+			c.ids[name] = ids
+		}
+	}
+}
+
+// load lists all zones, one page at a time, unless that was already done.
+// When the client is scoped to an organization only its zones are kept,
+// matching the SDK's ZoneIDByName. The caller must hold c.mu.
+func (c *zoneCache) load(client *cloudflare.API) error {
+	if c.loaded {
+		return nil
+	}
+
+	var zones []cloudflare.Zone
+	for page := 1; ; page++ {
+		res, err := client.ListZonesContext(context.Background(), cloudflare.WithPagination(cloudflare.PaginationOptions{
+			Page:    page,
+			PerPage: zoneCachePageSize,
+		}))
+		if err != nil {
+			return fmt.Errorf("error listing zones: %s", err)
+		}
+
+		for _, zone := range res.Result {
+			if client.OrganizationID != "" && zone.Account.ID != client.OrganizationID {
+				continue
+			}
+			zones = append(zones, zone)
+		}
+
+		if page >= res.ResultInfo.TotalPages {
+			break
+		}
+	}
+
+	log.Printf("[DEBUG] Filled zone cache with %d zones", len(zones))
+
+	c.zones = zones
+	for _, zone := range zones {
+		c.ids[zone.Name] = append(c.ids[zone.Name], zone.ID)
+	}
+	c.loaded = true
+
+	return nil
+}
+
+// accountZoneCaches holds a zone cache per account, for the clients of
+// resources that are managed in an account other than the provider's. It is
+// safe for concurrent use.
+type accountZoneCaches struct {
+	mu     sync.Mutex
+	caches map[string]*zoneCache
+}
+
+func newAccountZoneCaches() *accountZoneCaches {
+	return &accountZoneCaches{
+		caches: make(map[string]*zoneCache),
+	}
+}
+
+// get returns the zone cache of accountID, creating it on first use.
+func (a *accountZoneCaches) get(accountID string) *zoneCache {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cache, ok := a.caches[accountID]
+	if !ok {
+		cache = newZoneCache()
+		a.caches[accountID] = cache
+	}
+	return cache
+}
@@ -0,0 +1,174 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/terraform-providers/terraform-provider-cloudflare/internal/fakeapi"
+)
+
+type countingTransport struct {
+	requests int64
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt64(&t.requests, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func testZoneCacheClient(t *testing.T, server *fakeapi.Server) (*providerClient, *countingTransport) {
+	transport := &countingTransport{}
+	client, err := cloudflare.New("fake-api-key", "user@example.com", usingBaseURL(server.BaseURL()))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return newProviderClient(client, context.Background(), transport), transport
+}
+
+func TestZoneCache_SingleListing(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe")
+	defer server.Close()
+
+	ids := make(map[string]string)
+	for i := 0; i < 120; i++ {
+		name := fmt.Sprintf("zone-%d.example.com", i)
+		ids[name] = server.AddZone(name)
+	}
+
+	client, transport := testZoneCacheClient(t, server)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(ids))
+	for name, id := range ids {
+		wg.Add(1)
+		go func(name, id string) {
+			defer wg.Done()
+			zoneID, err := client.ZoneIDByName(name)
+			if err != nil {
+				errs <- err
+			} else if zoneID != id {
+				errs <- fmt.Errorf("expected zone %s to have ID %s, got %s", name, id, zoneID)
+			}
+		}(name, id)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	// 120 zones at 50 zones per page
+	if transport.requests != 3 {
+		t.Fatalf("expected the zones to be listed with 3 requests, got %d", transport.requests)
+	}
+
+	zones, err := client.allZones()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(zones) != len(ids) || transport.requests != 3 {
+		t.Fatalf("expected %d cached zones without further requests, got %d zones after %d requests", len(ids), len(zones), transport.requests)
+	}
+}
+
+func TestZoneCache_Miss(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer server.Close()
+
+	client, transport := testZoneCacheClient(t, server)
+
+	if _, err := client.ZoneIDByName("example.com"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// a zone created outside of the provider after the cache was filled
+	id := server.AddZone("example.org")
+	zoneID, err := client.ZoneIDByName("example.org")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if zoneID != id {
+		t.Fatalf("expected zone ID %s, got %s", id, zoneID)
+	}
+
+	if _, err := client.ZoneIDByName("example.org"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if transport.requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", transport.requests)
+	}
+
+	if _, err := client.ZoneIDByName("missing.example.net"); err == nil || !strings.Contains(err.Error(), "Zone could not be found") {
+		t.Fatalf("expected a zone not found error, got %v", err)
+	}
+}
+
+func TestZoneCache_AddRemove(t *testing.T) {
+	server := fakeapi.NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer server.Close()
+
+	client, transport := testZoneCacheClient(t, server)
+
+	zone, err := client.CreateZone("example.org", false, cloudflare.Organization{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client.zones.Add(zone)
+
+	zones, err := client.allZones()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(zones) != 2 {
+		t.Fatalf("expected 2 zones, got %d", len(zones))
+	}
+
+	client.zones.Add(zone)
+	client.zones.Remove(zones[0].ID)
+
+	zones, err = client.allZones()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(zones) != 1 || zones[0].ID != zone.ID {
+		t.Fatalf("expected only zone %s to be cached, got %#v", zone.ID, zones)
+	}
+	if zoneID, err := client.ZoneIDByName("example.org"); err != nil || zoneID != zone.ID {
+		t.Fatalf("expected zone ID %s, got %q (%v)", zone.ID, zoneID, err)
+	}
+	if transport.requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", transport.requests)
+	}
+}
+
+func TestZoneCache_SharedPerAccount(t *testing.T) {
+	other := "0b5ab7ef9c4d2e6f8a1b3c5d7e9f0a2b"
+	server := fakeapi.NewServer(other, "example.com")
+	defer server.Close()
+
+	client, transport := testZoneCacheClient(t, server)
+	client.OrganizationID = "f037e56e89293a057740de681ac9abbe"
+
+	for i := 0; i < 3; i++ {
+		timeoutClient, cancel := client.withTimeout(time.Minute)
+		if _, err := timeoutClient.withAccount(other).ZoneIDByName("example.com"); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		cancel()
+	}
+	if transport.requests != 1 {
+		t.Fatalf("expected the zones of account %s to be listed once, got %d requests", other, transport.requests)
+	}
+
+	if client.withAccount(other).zones == client.zones {
+		t.Fatal("expected the accounts not to share their zone caches")
+	}
+}
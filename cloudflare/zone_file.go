@@ -0,0 +1,651 @@
+package cloudflare
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// zoneFileAutomaticTTL is the TTL of records that don't state one in a zone
+// file without a $TTL directive. Cloudflare treats a TTL of 1 as automatic.
+const zoneFileAutomaticTTL = 1
+
+// zoneFileMaxStringLength is the longest character string a TXT record can
+// hold in a zone file, longer values are split into several strings.
+const zoneFileMaxStringLength = 255
+
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneFileEntry is one logical line of a zone file, which may span several
+// physical lines when it uses parentheses.
+type zoneFileEntry struct {
+	line     int
+	indented bool
+	tokens   []zoneFileToken
+	comment  string
+}
+
+// scanZoneFile splits the text of an RFC 1035 zone file into entries of
+// tokens, handling comments, quoted strings and parentheses.
+func scanZoneFile(text string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	line := 1
+	entry := zoneFileEntry{line: line}
+	parens := 0
+	startOfLine := true
+
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			if parens == 0 {
+				if len(entry.tokens) > 0 {
+					entries = append(entries, entry)
+				}
+				entry = zoneFileEntry{line: line}
+				startOfLine = true
+			}
+			continue
+
+		case c == ' ' || c == '\t' || c == '\r':
+			if startOfLine {
+				entry.indented = true
+			}
+			i++
+
+		case c == ';':
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				end = len(text) - i
+			}
+			entry.comment = strings.TrimSpace(entry.comment + " " + text[i+1:i+end])
+			i += end
+
+		case c == '(':
+			parens++
+			i++
+
+		case c == ')':
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			parens--
+			i++
+
+		case c == '"':
+			var b bytes.Buffer
+			j := i + 1
+			for ; j < len(text) && text[j] != '"'; j++ {
+				if text[j] == '\n' {
+					line++
+				}
+				if text[j] == '\\' && j+1 < len(text) {
+					j += zoneFileUnescape(text[j+1:], &b)
+					continue
+				}
+				b.WriteByte(text[j])
+			}
+			if j >= len(text) {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			}
+			entry.tokens = append(entry.tokens, zoneFileToken{text: b.String(), quoted: true})
+			i = j + 1
+
+		default:
+			var b bytes.Buffer
+			j := i
+			for ; j < len(text) && !strings.ContainsRune(" \t\r\n;()\"", rune(text[j])); j++ {
+				if text[j] == '\\' && j+1 < len(text) {
+					j += zoneFileUnescape(text[j+1:], &b)
+					continue
+				}
+				b.WriteByte(text[j])
+			}
+			entry.tokens = append(entry.tokens, zoneFileToken{text: b.String()})
+			i = j
+		}
+		startOfLine = false
+	}
+
+	if parens != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+	if len(entry.tokens) > 0 {
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// zoneFileUnescape writes the character escaped by the backslash preceding s,
+// either \X or \DDD, and returns the number of bytes of s it consumed.
+func zoneFileUnescape(s string, b *bytes.Buffer) int {
+	if len(s) >= 3 && isDigits(s[:3]) {
+		if n, err := strconv.Atoi(s[:3]); err == nil && n < 256 {
+			b.WriteByte(byte(n))
+			return 3
+		}
+	}
+	b.WriteByte(s[0])
+	return 1
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// parseZoneFile parses the records of an RFC 1035 zone file for zoneName
+// into the records the Cloudflare API expects. SOA and apex NS records are
+// skipped as Cloudflare manages them, and a "cf_tags=cf-proxied:true" comment,
+// as written by Cloudflare's own zone file export, marks a record as proxied.
+func parseZoneFile(text, zoneName string) ([]cloudflare.DNSRecord, error) {
+	entries, err := scanZoneFile(text)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+	origin := zoneName
+	defaultTTL := zoneFileAutomaticTTL
+	owner := ""
+
+	var records []cloudflare.DNSRecord
+	seen := make(map[string]int)
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if !entry.indented && !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			if len(tokens) < 2 {
+				return nil, fmt.Errorf("line %d: %s needs an argument", entry.line, tokens[0].text)
+			}
+			switch strings.ToUpper(tokens[0].text) {
+			case "$ORIGIN":
+				origin = zoneFileName(tokens[1].text, origin)
+			case "$TTL":
+				if defaultTTL, err = parseZoneFileTTL(tokens[1].text); err != nil {
+					return nil, fmt.Errorf("line %d: %s", entry.line, err)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", entry.line, tokens[0].text)
+			}
+			continue
+		}
+
+		if !entry.indented {
+			owner = zoneFileName(tokens[0].text, origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+		}
+
+		ttl := defaultTTL
+		for len(tokens) > 0 && !tokens[0].quoted {
+			if class := strings.ToUpper(tokens[0].text); class == "IN" {
+				tokens = tokens[1:]
+				continue
+			} else if class == "CH" || class == "HS" || class == "CS" {
+				return nil, fmt.Errorf("line %d: unsupported class %s", entry.line, class)
+			}
+			v, err := parseZoneFileTTL(tokens[0].text)
+			if err != nil {
+				break
+			}
+			ttl = v
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record has no type", entry.line)
+		}
+
+		recordType := strings.ToUpper(tokens[0].text)
+		if zoneFileManagedByCloudflare(owner, recordType, zoneName) {
+			log.Printf("[DEBUG] Skipping %s record on line %d of zone file", recordType, entry.line)
+			continue
+		}
+		if owner != zoneName && !strings.HasSuffix(owner, "."+zoneName) {
+			return nil, fmt.Errorf("line %d: %s is outside of zone %s", entry.line, owner, zoneName)
+		}
+
+		record, err := parseZoneFileRecord(owner, recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", entry.line, err)
+		}
+		record.Proxied = zoneFileProxied(entry.comment)
+		if err := validateRecordType(recordType, record.Proxied); err != nil {
+			return nil, fmt.Errorf("line %d: %s", entry.line, err)
+		}
+
+		// Cloudflare ignores the TTL of proxied records
+		record.TTL = ttl
+		if record.Proxied {
+			record.TTL = zoneFileAutomaticTTL
+		}
+
+		key := zoneFileRecordKey(record)
+		if line, ok := seen[key]; ok {
+			return nil, fmt.Errorf("line %d: duplicate of the record on line %d", entry.line, line)
+		}
+		seen[key] = entry.line
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// zoneFileManagedByCloudflare reports whether Cloudflare manages the records
+// of recordType named owner: the SOA record and the NS records of the apex,
+// which list the zone's Cloudflare nameservers.
+func zoneFileManagedByCloudflare(owner, recordType, zoneName string) bool {
+	return recordType == "SOA" || (recordType == "NS" && owner == zoneName)
+}
+
+// parseZoneFileRecord builds a record of recordType from the fields of its
+// RDATA, using the structured data block for the types that need one.
+func parseZoneFileRecord(owner, recordType string, rdata []zoneFileToken, origin string) (cloudflare.DNSRecord, error) {
+	record := cloudflare.DNSRecord{
+		Name: owner,
+		Type: recordType,
+	}
+
+	min, max := 0, 0
+	switch recordType {
+	case "A", "AAAA", "CNAME", "NS":
+		min, max = 1, 1
+	case "MX":
+		min, max = 2, 2
+	case "TXT", "SPF":
+		min, max = 1, len(rdata)
+	case "CAA", "URI":
+		min, max = 3, 3
+	case "SSHFP":
+		min, max = 3, len(rdata)
+	case "SRV":
+		min, max = 4, 4
+	case "CERT", "DNSKEY", "DS", "SMIMEA", "TLSA":
+		min, max = 4, len(rdata)
+	case "NAPTR":
+		min, max = 6, 6
+	case "LOC":
+		min, max = 5, len(rdata)
+	default:
+		return record, validateRecordType(recordType, false)
+	}
+	if len(rdata) < min || len(rdata) > max {
+		return record, fmt.Errorf("wrong number of fields for %s record: %d", recordType, len(rdata))
+	}
+
+	var err error
+	var data map[string]interface{}
+	switch recordType {
+	case "A", "AAAA":
+		record.Content = rdata[0].text
+		if err := validateRecordName(recordType, record.Content); err != nil {
+			return record, err
+		}
+	case "CNAME", "NS":
+		record.Content = zoneFileName(rdata[0].text, origin)
+	case "MX":
+		record.Priority, err = strconv.Atoi(rdata[0].text)
+		record.Content = zoneFileName(rdata[1].text, origin)
+	case "TXT", "SPF":
+		var b bytes.Buffer
+		for _, t := range rdata {
+			b.WriteString(t.text)
+		}
+		record.Content = b.String()
+	case "SRV":
+		labels := strings.SplitN(owner, ".", 3)
+		if len(labels) != 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return record, fmt.Errorf("SRV record name %s must be of the form _service._proto.name", owner)
+		}
+		data, err = zoneFileInts(rdata, "priority", "weight", "port")
+		data["service"] = labels[0]
+		data["proto"] = labels[1]
+		data["name"] = labels[2]
+		data["target"] = zoneFileName(rdata[3].text, origin)
+	case "CAA":
+		data, err = zoneFileInts(rdata, "flags")
+		data["tag"] = rdata[1].text
+		data["value"] = rdata[2].text
+	case "CERT":
+		data, err = zoneFileInts(rdata, "type", "key_tag", "algorithm")
+		data["certificate"] = zoneFileJoin(rdata[3:])
+	case "DNSKEY":
+		data, err = zoneFileInts(rdata, "flags", "protocol", "algorithm")
+		data["public_key"] = zoneFileJoin(rdata[3:])
+	case "DS":
+		data, err = zoneFileInts(rdata, "key_tag", "algorithm", "digest_type")
+		data["digest"] = zoneFileJoin(rdata[3:])
+	case "NAPTR":
+		data, err = zoneFileInts(rdata, "order", "preference")
+		data["flags"] = rdata[2].text
+		data["service"] = rdata[3].text
+		data["regex"] = rdata[4].text
+		data["replacement"] = zoneFileName(rdata[5].text, origin)
+	case "SMIMEA", "TLSA":
+		data, err = zoneFileInts(rdata, "usage", "selector", "matching_type")
+		data["certificate"] = zoneFileJoin(rdata[3:])
+	case "SSHFP":
+		data, err = zoneFileInts(rdata, "algorithm", "type")
+		data["fingerprint"] = zoneFileJoin(rdata[2:])
+	case "URI":
+		record.Priority, err = strconv.Atoi(rdata[0].text)
+		if err == nil {
+			data, err = zoneFileInts(rdata[1:], "weight")
+			data["content"] = rdata[2].text
+		}
+	case "LOC":
+		data, err = parseZoneFileLOC(rdata)
+	}
+	if err != nil {
+		return record, fmt.Errorf("invalid %s record: %s", recordType, err)
+	}
+
+	if data != nil {
+		record.Data = data
+	}
+	return record, nil
+}
+
+// zoneFileInts parses the leading fields of rdata as the integer fields
+// named by keys of a record's data block.
+func zoneFileInts(rdata []zoneFileToken, keys ...string) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	for i, key := range keys {
+		v, err := strconv.Atoi(rdata[i].text)
+		if err != nil {
+			return data, fmt.Errorf("%s must be a number, got %q", key, rdata[i].text)
+		}
+		data[key] = v
+	}
+	return data, nil
+}
+
+// zoneFileJoin joins fields which may be split by whitespace, such as base64
+// keys or hex digests.
+func zoneFileJoin(tokens []zoneFileToken) string {
+	var b bytes.Buffer
+	for _, t := range tokens {
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+// parseZoneFileLOC parses the RDATA of a LOC record as described by RFC 1876:
+// d1 [m1 [s1]] {N|S} d2 [m2 [s2]] {E|W} alt[m] [siz[m] [hp[m] [vp[m]]]]
+func parseZoneFileLOC(rdata []zoneFileToken) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	i := 0
+
+	for _, coordinate := range []struct{ prefix, directions string }{{"lat", "NS"}, {"long", "EW"}} {
+		values := []string{"0", "0", "0"}
+		for n := 0; ; n++ {
+			if i >= len(rdata) {
+				return nil, fmt.Errorf("missing %s direction", coordinate.prefix)
+			}
+			t := strings.ToUpper(rdata[i].text)
+			i++
+			if len(t) == 1 && strings.Contains(coordinate.directions, t) && n > 0 {
+				data[coordinate.prefix+"_direction"] = t
+				break
+			}
+			if n >= len(values) {
+				return nil, fmt.Errorf("invalid %s direction %q", coordinate.prefix, rdata[i-1].text)
+			}
+			values[n] = t
+		}
+
+		degrees, err := strconv.Atoi(values[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s degrees %q", coordinate.prefix, values[0])
+		}
+		minutes, err := strconv.Atoi(values[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s minutes %q", coordinate.prefix, values[1])
+		}
+		seconds, err := strconv.ParseFloat(values[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s seconds %q", coordinate.prefix, values[2])
+		}
+		data[coordinate.prefix+"_degrees"] = degrees
+		data[coordinate.prefix+"_minutes"] = minutes
+		data[coordinate.prefix+"_seconds"] = seconds
+	}
+
+	// the altitude is required, the rest default as in RFC 1876
+	sizes := []struct {
+		key   string
+		value float64
+	}{{"altitude", 0}, {"size", 1}, {"precision_horz", 10000}, {"precision_vert", 10}}
+	if len(rdata)-i < 1 || len(rdata)-i > len(sizes) {
+		return nil, fmt.Errorf("wrong number of altitude and precision fields: %d", len(rdata)-i)
+	}
+	for n, size := range sizes {
+		if i+n < len(rdata) {
+			t := strings.TrimSuffix(strings.ToLower(rdata[i+n].text), "m")
+			v, err := strconv.ParseFloat(t, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q", size.key, rdata[i+n].text)
+			}
+			size.value = v
+		}
+		data[size.key] = size.value
+	}
+
+	return data, nil
+}
+
+// parseZoneFileTTL parses a TTL given either in seconds or with BIND's unit
+// suffixes, e.g. 1h30m.
+func parseZoneFileTTL(s string) (int, error) {
+	if isDigits(s) {
+		return strconv.Atoi(s)
+	}
+
+	units := map[rune]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, n, digits := 0, 0, false
+	for _, c := range strings.ToLower(s) {
+		switch {
+		case unicode.IsDigit(c):
+			n = n*10 + int(c-'0')
+			digits = true
+		case units[c] != 0 && digits:
+			ttl += n * units[c]
+			n, digits = 0, false
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+	}
+	if digits || s == "" {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return ttl, nil
+}
+
+// zoneFileName makes a domain name of a zone file absolute, returning it in
+// lower case and without the trailing dot.
+func zoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case name == ".":
+		return name
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	}
+	return strings.ToLower(name) + "." + origin
+}
+
+func zoneFileProxied(comment string) bool {
+	for _, field := range strings.Fields(comment) {
+		if !strings.HasPrefix(field, "cf_tags=") {
+			continue
+		}
+		for _, tag := range strings.Split(strings.TrimPrefix(field, "cf_tags="), ",") {
+			if tag == "cf-proxied:true" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// renderZoneFile writes records of zoneName in zone file format, sorted by
+// name and type so that the same records always render the same way.
+func renderZoneFile(records []cloudflare.DNSRecord, zoneName string) string {
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	lines := make([]string, 0, len(records))
+	for _, record := range records {
+		name := strings.ToLower(record.Name)
+		switch {
+		case name == zoneName:
+			name = "@"
+		case strings.HasSuffix(name, "."+zoneName):
+			name = strings.TrimSuffix(name, "."+zoneName)
+		default:
+			name = name + "."
+		}
+
+		line := fmt.Sprintf("%s\t%d\tIN\t%s\t%s", name, record.TTL, record.Type, zoneFileRData(record))
+		if record.Proxied {
+			line += " ; cf_tags=cf-proxied:true"
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", zoneName)
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// zoneFileRecordKey identifies a record by its name, type and RDATA, which
+// is what tells records apart in a zone file.
+func zoneFileRecordKey(record cloudflare.DNSRecord) string {
+	return strings.ToLower(record.Name) + " " + record.Type + " " + zoneFileRData(record)
+}
+
+// zoneFileRData renders the RDATA of a record, from its data block for the
+// types that have one.
+func zoneFileRData(record cloudflare.DNSRecord) string {
+	switch record.Type {
+	case "AAAA":
+		if ip := net.ParseIP(record.Content); ip != nil {
+			return ip.String()
+		}
+	case "CNAME", "NS":
+		return zoneFileFQDN(record.Content)
+	case "MX":
+		return fmt.Sprintf("%d %s", record.Priority, zoneFileFQDN(record.Content))
+	case "TXT", "SPF":
+		return zoneFileQuote(joinRecordTXTChunks(record.Content))
+	}
+
+	data, _ := record.Data.(map[string]interface{})
+	if len(data) == 0 {
+		return record.Content
+	}
+
+	v := func(keys ...string) string {
+		values := make([]string, len(keys))
+		for i, key := range keys {
+			values[i] = zoneFileValue(data[key])
+		}
+		return strings.Join(values, " ")
+	}
+
+	switch record.Type {
+	case "SRV":
+		return v("priority", "weight", "port") + " " + zoneFileFQDN(zoneFileValue(data["target"]))
+	case "CAA":
+		return v("flags", "tag") + " " + zoneFileQuote(zoneFileValue(data["value"]))
+	case "CERT":
+		return v("type", "key_tag", "algorithm", "certificate")
+	case "DNSKEY":
+		return v("flags", "protocol", "algorithm", "public_key")
+	case "DS":
+		return v("key_tag", "algorithm", "digest_type", "digest")
+	case "NAPTR":
+		return fmt.Sprintf("%s %s %s %s %s", v("order", "preference"),
+			zoneFileQuote(zoneFileValue(data["flags"])), zoneFileQuote(zoneFileValue(data["service"])),
+			zoneFileQuote(zoneFileValue(data["regex"])), zoneFileFQDN(zoneFileValue(data["replacement"])))
+	case "SMIMEA", "TLSA":
+		return v("usage", "selector", "matching_type", "certificate")
+	case "SSHFP":
+		return v("algorithm", "type", "fingerprint")
+	case "URI":
+		return fmt.Sprintf("%d %s %s", record.Priority, v("weight"), zoneFileQuote(zoneFileValue(data["content"])))
+	case "LOC":
+		return fmt.Sprintf("%s %s %sm %sm %sm %sm",
+			v("lat_degrees", "lat_minutes", "lat_seconds", "lat_direction"),
+			v("long_degrees", "long_minutes", "long_seconds", "long_direction"),
+			zoneFileValue(data["altitude"]), zoneFileValue(data["size"]),
+			zoneFileValue(data["precision_horz"]), zoneFileValue(data["precision_vert"]))
+	}
+
+	return record.Content
+}
+
+// zoneFileValue formats a value of a data block, which holds numbers decoded
+// from JSON as float64 when read from the API.
+func zoneFileValue(v interface{}) string {
+	switch n := v.(type) {
+	case nil:
+		return ""
+	case int:
+		return strconv.Itoa(n)
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func zoneFileFQDN(name string) string {
+	if name == "." || strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// zoneFileQuote quotes text as one or more character strings.
+func zoneFileQuote(text string) string {
+	var chunks []string
+	for {
+		n := len(text)
+		if n > zoneFileMaxStringLength {
+			n = zoneFileMaxStringLength
+		}
+
+		chunk := strings.Replace(text[:n], `\`, `\\`, -1)
+		chunk = strings.Replace(chunk, `"`, `\"`, -1)
+		chunks = append(chunks, `"`+chunk+`"`)
+
+		text = text[n:]
+		if text == "" {
+			break
+		}
+	}
+	return strings.Join(chunks, " ")
+}
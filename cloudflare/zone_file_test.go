@@ -0,0 +1,188 @@
+package cloudflare
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	text := `$ORIGIN example.com.
+$TTL 1h
+; Cloudflare manages the SOA record
+@	IN	SOA	ns1.example.net. hostmaster.example.com. (
+		2019060101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+; and the nameservers of the apex
+@		NS	ns1.example.net.
+example.com.	NS	ns2.example.net.
+@		IN	MX	10 mx1
+		IN	MX	20 mx2.example.net.
+www	300	IN	A	192.0.2.1 ; cf_tags=cf-proxied:true
+www		IN	AAAA	2001:DB8::1
+api	IN	1d	CNAME	www
+txt		TXT	"v=spf1 include:_spf.example.net ~all"
+long		TXT	( "first part, "
+		  "second part with a \"quote\"" )
+_sip._udp	SRV	10 60 5060 sip
+@		CAA	0 issue "letsencrypt.org"
+@		LOC	52 22 23.000 N 4 53 32.000 E -2.00m 0.00m 10000m 10m
+sub		NS	ns1.example.net.
+_443._tcp	TLSA	3 1 1 ( 0C72AC70B745AC19998811B131D662C9
+		AC69DBDBE7CB23E5B514B56664C5D3D6 )
+@		SSHFP	4 2 123456789ABCDEF67890123456789ABCDEF67890123456789ABCDEF123456789A
+@		URI	10 1 "https://example.com/"
+@		DS	2371 13 2 1F987CC6583E92DF0890718C42
+@		NAPTR	100 10 "S" "SIP+D2U" "" _sip._udp
+$ORIGIN dev.example.com.
+*		A	192.0.2.10
+`
+
+	records, err := parseZoneFile(text, "example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []cloudflare.DNSRecord{
+		{Name: "example.com", Type: "MX", Content: "mx1.example.com", Priority: 10, TTL: 3600},
+		{Name: "example.com", Type: "MX", Content: "mx2.example.net", Priority: 20, TTL: 3600},
+		{Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 1, Proxied: true},
+		{Name: "www.example.com", Type: "AAAA", Content: "2001:DB8::1", TTL: 3600},
+		{Name: "api.example.com", Type: "CNAME", Content: "www.example.com", TTL: 86400},
+		{Name: "txt.example.com", Type: "TXT", Content: "v=spf1 include:_spf.example.net ~all", TTL: 3600},
+		{Name: "long.example.com", Type: "TXT", Content: `first part, second part with a "quote"`, TTL: 3600},
+		{Name: "_sip._udp.example.com", Type: "SRV", TTL: 3600, Data: map[string]interface{}{
+			"service": "_sip", "proto": "_udp", "name": "example.com",
+			"priority": 10, "weight": 60, "port": 5060, "target": "sip.example.com",
+		}},
+		{Name: "example.com", Type: "CAA", TTL: 3600, Data: map[string]interface{}{
+			"flags": 0, "tag": "issue", "value": "letsencrypt.org",
+		}},
+		{Name: "example.com", Type: "LOC", TTL: 3600, Data: map[string]interface{}{
+			"lat_degrees": 52, "lat_minutes": 22, "lat_seconds": 23.0, "lat_direction": "N",
+			"long_degrees": 4, "long_minutes": 53, "long_seconds": 32.0, "long_direction": "E",
+			"altitude": -2.0, "size": 0.0, "precision_horz": 10000.0, "precision_vert": 10.0,
+		}},
+		{Name: "sub.example.com", Type: "NS", Content: "ns1.example.net", TTL: 3600},
+		{Name: "_443._tcp.example.com", Type: "TLSA", TTL: 3600, Data: map[string]interface{}{
+			"usage": 3, "selector": 1, "matching_type": 1,
+			"certificate": "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6",
+		}},
+		{Name: "example.com", Type: "SSHFP", TTL: 3600, Data: map[string]interface{}{
+			"algorithm": 4, "type": 2, "fingerprint": "123456789ABCDEF67890123456789ABCDEF67890123456789ABCDEF123456789A",
+		}},
+		{Name: "example.com", Type: "URI", Priority: 10, TTL: 3600, Data: map[string]interface{}{
+			"weight": 1, "content": "https://example.com/",
+		}},
+		{Name: "example.com", Type: "DS", TTL: 3600, Data: map[string]interface{}{
+			"key_tag": 2371, "algorithm": 13, "digest_type": 2, "digest": "1F987CC6583E92DF0890718C42",
+		}},
+		{Name: "example.com", Type: "NAPTR", TTL: 3600, Data: map[string]interface{}{
+			"order": 100, "preference": 10, "flags": "S", "service": "SIP+D2U", "regex": "", "replacement": "_sip._udp.example.com",
+		}},
+		{Name: "*.dev.example.com", Type: "A", Content: "192.0.2.10", TTL: 3600},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d: %#v", len(expected), len(records), records)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(records[i], expected[i]) {
+			t.Errorf("record %d: expected %#v, got %#v", i, expected[i], records[i])
+		}
+	}
+}
+
+func TestParseZoneFile_Errors(t *testing.T) {
+	cases := []struct {
+		text, err string
+	}{
+		{"www A 192.0.2.1 192.0.2.2", "line 1: wrong number of fields for A record: 2"},
+		{"www A example.com", "line 1: A record must be a valid IPv4 address"},
+		{"\nwww PTR example.com.", `line 2: Invalid type "PTR"`},
+		{"www MX ten mx", `line 1: invalid MX record`},
+		{"www.example.net. A 192.0.2.1", "line 1: www.example.net is outside of zone example.com"},
+		{"  A 192.0.2.1", "line 1: record has no owner name"},
+		{"www TXT \"unterminated", "unterminated quoted string"},
+		{"www TXT ( \"a\"", "unbalanced parentheses"},
+		{"$INCLUDE other.zone", "line 1: unsupported directive $INCLUDE"},
+		{"www MX 10 mx ; cf_tags=cf-proxied:true", `Type "MX" cannot be proxied`},
+		{"sip SRV 10 60 5060 sip", "SRV record name sip.example.com must be of the form _service._proto.name"},
+		{"www A 192.0.2.1\nwww 300 A 192.0.2.1", "line 2: duplicate of the record on line 1"},
+	}
+
+	for _, c := range cases {
+		_, err := parseZoneFile(c.text, "example.com")
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%q: expected error %q, got %v", c.text, c.err, err)
+		}
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	cases := []struct {
+		ttl      string
+		expected int
+		ok       bool
+	}{
+		{"300", 300, true},
+		{"1h30m", 5400, true},
+		{"1W", 604800, true},
+		{"2d12h", 216000, true},
+		{"h", 0, false},
+		{"10x", 0, false},
+		{"30m5", 0, false},
+		{"IN", 0, false},
+	}
+	for _, c := range cases {
+		ttl, err := parseZoneFileTTL(c.ttl)
+		if (err == nil) != c.ok || ttl != c.expected {
+			t.Errorf("%q: expected %d (ok: %t), got %d (%v)", c.ttl, c.expected, c.ok, ttl, err)
+		}
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	// records as read from the API, with numbers decoded from JSON
+	records := []cloudflare.DNSRecord{
+		{Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 1, Proxied: true},
+		{Name: "example.com", Type: "MX", Content: "mx.example.com", Priority: 10, TTL: 3600},
+		{Name: "example.com", Type: "TXT", Content: strings.Repeat("a", 300) + `"\`, TTL: 1},
+		{Name: "_sip._udp.example.com", Type: "SRV", Content: "60\t5060\tsip.example.com", TTL: 1, Data: map[string]interface{}{
+			"service": "_sip", "proto": "_udp", "name": "example.com",
+			"priority": float64(10), "weight": float64(60), "port": float64(5060), "target": "sip.example.com",
+		}},
+		{Name: "example.com", Type: "LOC", TTL: 1, Data: map[string]interface{}{
+			"lat_degrees": float64(52), "lat_minutes": float64(22), "lat_seconds": 23.5, "lat_direction": "N",
+			"long_degrees": float64(4), "long_minutes": float64(53), "long_seconds": float64(32), "long_direction": "E",
+			"altitude": float64(-2), "size": float64(1), "precision_horz": float64(10000), "precision_vert": float64(10),
+		}},
+	}
+
+	expected := `$ORIGIN example.com.
+@	1	IN	LOC	52 22 23.5 N 4 53 32 E -2m 1m 10000m 10m
+@	1	IN	TXT	"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `\"\\"
+@	3600	IN	MX	10 mx.example.com.
+_sip._udp	1	IN	SRV	10 60 5060 sip.example.com.
+www	1	IN	A	192.0.2.1 ; cf_tags=cf-proxied:true
+`
+
+	text := renderZoneFile(records, "example.com")
+	if text != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, text)
+	}
+
+	// the rendered zone file parses back to the same records
+	parsed, err := parseZoneFile(text, "example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if rendered := renderZoneFile(parsed, "example.com"); rendered != text {
+		t.Fatalf("expected the zone file to round trip, got:\n%s", rendered)
+	}
+}
@@ -0,0 +1,103 @@
+// Command cloudflare-export writes the Terraform configuration of existing
+// Cloudflare zones, along with a script of the terraform import commands that
+// bring them under management.
+//
+// Usage:
+//
+//	cloudflare-export [-out dir] zone...
+//
+// Credentials and the other provider settings are read from the same
+// environment variables as the provider, e.g. CLOUDFLARE_EMAIL and
+// CLOUDFLARE_TOKEN. For each zone, <zone>.tf and <zone>-import.sh are written
+// to the output directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/logging"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-cloudflare/cloudflare"
+)
+
+func main() {
+	out := flag.String("out", ".", "directory to write the configuration and import scripts to")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-out dir] zone...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// honour TF_LOG and TF_LOG_PATH like Terraform does
+	if w, err := logging.LogOutput(); err == nil {
+		log.SetOutput(w)
+	}
+
+	p, err := configureProvider()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring the provider: %s\n", err)
+		os.Exit(1)
+	}
+
+	failed := false
+	for _, zoneName := range flag.Args() {
+		if err := exportZone(p, zoneName, *out); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting zone %s: %s\n", zoneName, err)
+			failed = true
+			continue
+		}
+		fmt.Printf("Exported zone %s\n", zoneName)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// configureProvider configures the provider from its environment variables,
+// as Terraform does for an empty provider block.
+func configureProvider() (*schema.Provider, error) {
+	p := cloudflare.Provider().(*schema.Provider)
+
+	raw, err := config.NewRawConfig(map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	rc := terraform.NewResourceConfig(raw)
+
+	if _, errs := p.Validate(rc); len(errs) > 0 {
+		return nil, errs[0]
+	}
+	if err := p.Configure(rc); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func exportZone(p *schema.Provider, zoneName, dir string) error {
+	var configBuf, scriptBuf bytes.Buffer
+	scriptBuf.WriteString("#!/bin/sh\nset -e\n\n")
+
+	if err := cloudflare.ExportZone(p, zoneName, &configBuf, &scriptBuf); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, zoneName+".tf"), configBuf.Bytes(), 0644); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, zoneName+"-import.sh"), scriptBuf.Bytes(), 0755)
+}
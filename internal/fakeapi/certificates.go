@@ -0,0 +1,76 @@
+package fakeapi
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"time"
+)
+
+// validities are the values the Origin CA accepts for requested_validity.
+var validities = map[int]bool{7: true, 30: true, 90: true, 365: true, 730: true, 1095: true, 5475: true}
+
+// handleCertificates implements the Origin CA API, which unlike the rest of
+// the API only accepts a user service key or an API token.
+func (s *Server) handleCertificates(req *request, segments []string) (interface{}, *resultInfo, error) {
+	if req.header.Get("X-Auth-User-Service-Key") == "" && req.header.Get("Authorization") == "" {
+		return nil, nil, badRequest(10001, "Unable to authenticate request: missing user service key")
+	}
+
+	c := s.collection("/certificates")
+
+	switch {
+	case len(segments) == 1 && req.method == http.MethodGet:
+		return paginate(filterList(c.list(), req.query), req.query, 100)
+	case len(segments) == 1 && req.method == http.MethodPost:
+		return s.createCertificate(c, req.body)
+	case len(segments) == 2:
+		cert, ok := c.items[segments[1]]
+		if !ok {
+			return nil, nil, notFound(1001, "Certificate not found")
+		}
+		switch req.method {
+		case http.MethodGet:
+			return cert, nil, nil
+		case http.MethodDelete:
+			c.remove(segments[1])
+			return map[string]interface{}{"id": segments[1]}, nil, nil
+		}
+	}
+	return nil, nil, routeNotFound(req.path)
+}
+
+func (s *Server) createCertificate(c *collection, body []byte) (interface{}, *resultInfo, error) {
+	obj, err := decodeObject(body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csr, _ := pem.Decode([]byte(stringValue(obj["csr"])))
+	if csr == nil || csr.Type != "CERTIFICATE REQUEST" {
+		return nil, nil, badRequest(1010, "Failed to parse CSR")
+	}
+	if hostnames, _ := obj["hostnames"].([]interface{}); len(hostnames) == 0 {
+		return nil, nil, badRequest(1013, "Hostnames are required")
+	}
+
+	validity := int(float(obj["requested_validity"]))
+	if validity == 0 {
+		validity = 5475
+	}
+	if !validities[validity] {
+		return nil, nil, badRequest(1014, "Invalid requested_validity")
+	}
+
+	id := newID()
+	obj["id"] = id
+	obj["requested_validity"] = validity
+	obj["expires_on"] = time.Now().UTC().AddDate(0, 0, validity).Truncate(time.Second).Format(time.RFC3339)
+	obj["certificate"] = string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: []byte(base64.StdEncoding.EncodeToString([]byte("fake origin certificate " + id))),
+	}))
+
+	c.add(obj)
+	return obj, nil, nil
+}
@@ -0,0 +1,147 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// defaultNameServers are the Cloudflare nameservers every fake zone is
+// assigned.
+var defaultNameServers = []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"}
+
+// handleAccountCustomNS implements an account's custom nameservers. Each is
+// assigned a pair of glue addresses and must be a subdomain of one of the
+// account's zones.
+func (s *Server) handleAccountCustomNS(req *request, accountID string, rest []string) (interface{}, *resultInfo, error) {
+	nameservers := s.customNS[accountID]
+
+	switch {
+	case len(rest) == 0 && req.method == http.MethodGet:
+		names := make([]string, 0, len(nameservers))
+		for name := range nameservers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		out := make([]map[string]interface{}, 0, len(names))
+		for _, name := range names {
+			out = append(out, nameservers[name])
+		}
+		return out, nil, nil
+
+	case len(rest) == 0 && req.method == http.MethodPost:
+		in, err := decodeObject(req.body)
+		if err != nil {
+			return nil, nil, err
+		}
+		name, _ := in["ns_name"].(string)
+		name = strings.TrimSuffix(strings.ToLower(name), ".")
+		if name == "" {
+			return nil, nil, badRequest(1001, "ns_name is required")
+		}
+		if _, ok := nameservers[name]; ok {
+			return nil, nil, badRequest(1061, "Custom nameserver "+name+" already exists")
+		}
+		set, _ := in["ns_set"].(float64)
+		if set == 0 {
+			set = 1
+		}
+		if set < 1 || set > 5 {
+			return nil, nil, badRequest(1001, "ns_set must be between 1 and 5")
+		}
+
+		var zoneTag string
+		for _, zone := range s.zones.list() {
+			zoneName := zone["name"].(string)
+			if strings.HasSuffix(name, "."+zoneName) && lookupString(zone, "account.id") == accountID {
+				zoneTag = zone["id"].(string)
+			}
+		}
+		if zoneTag == "" {
+			return nil, nil, badRequest(1001, "Custom nameserver "+name+" must be a subdomain of a zone in the account")
+		}
+
+		if nameservers == nil {
+			nameservers = make(map[string]map[string]interface{})
+			s.customNS[accountID] = nameservers
+		}
+		s.glueAddresses++
+		nameserver := map[string]interface{}{
+			"ns_name":  name,
+			"ns_set":   int(set),
+			"status":   "verified",
+			"zone_tag": zoneTag,
+			"dns_records": []map[string]interface{}{
+				{"type": "A", "value": fmt.Sprintf("198.51.100.%d", s.glueAddresses)},
+				{"type": "AAAA", "value": fmt.Sprintf("2001:db8:100::%x", s.glueAddresses)},
+			},
+		}
+		nameservers[name] = nameserver
+		return nameserver, nil, nil
+
+	case len(rest) == 1 && req.method == http.MethodDelete:
+		name := strings.ToLower(rest[0])
+		if _, ok := nameservers[name]; !ok {
+			return nil, nil, notFound(1003, "Custom nameserver "+name+" not found")
+		}
+		delete(nameservers, name)
+		return nil, nil, nil
+	}
+
+	return nil, nil, routeNotFound(req.path)
+}
+
+// handleZoneCustomNS implements whether a zone uses the custom nameservers of
+// one of its account's sets instead of Cloudflare's.
+func (s *Server) handleZoneCustomNS(req *request, zone map[string]interface{}) (interface{}, *resultInfo, error) {
+	zoneID := zone["id"].(string)
+	setting, ok := s.zoneCustomNS[zoneID]
+	if !ok {
+		setting = map[string]interface{}{"enabled": false, "ns_set": 1}
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return setting, nil, nil
+
+	case http.MethodPut:
+		in, err := decodeObject(req.body)
+		if err != nil {
+			return nil, nil, err
+		}
+		enabled, _ := in["enabled"].(bool)
+		set, _ := in["ns_set"].(float64)
+		if set == 0 {
+			set = 1
+		}
+
+		nameServers := defaultNameServers
+		if enabled {
+			nameServers = nil
+			for name, nameserver := range s.customNS[lookupString(zone, "account.id")] {
+				if nameserver["ns_set"] == int(set) {
+					nameServers = append(nameServers, name)
+				}
+			}
+			if len(nameServers) == 0 {
+				return nil, nil, badRequest(1001, fmt.Sprintf("The account has no custom nameservers in set %d", int(set)))
+			}
+			sort.Strings(nameServers)
+		}
+
+		setting = map[string]interface{}{"enabled": enabled, "ns_set": int(set)}
+		s.zoneCustomNS[zoneID] = setting
+		zone["name_servers"] = nameServers
+		zone["modified_on"] = timestamp()
+		return setting, nil, nil
+	}
+
+	return nil, nil, routeNotFound(req.path)
+}
+
+func lookupString(obj map[string]interface{}, key string) string {
+	v, _ := lookup(obj, key)
+	s, _ := v.(string)
+	return s
+}
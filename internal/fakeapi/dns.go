@@ -0,0 +1,179 @@
+package fakeapi
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strings"
+)
+
+// proxiableTypes are the record types the API allows to be proxied.
+var proxiableTypes = map[string]bool{
+	"A":     true,
+	"AAAA":  true,
+	"CNAME": true,
+}
+
+// normalizeDNSRecord fills in the fields the API derives for a DNS record and
+// rejects records that would conflict with an existing one.
+func (s *Server) normalizeDNSRecord(c *collection, path string, obj map[string]interface{}) error {
+	zoneID := strings.Split(strings.TrimPrefix(path, "/"), "/")[1]
+	zoneName := s.zones.items[zoneID]["name"].(string)
+
+	recordType := strings.ToUpper(stringValue(obj["type"]))
+	if recordType == "" {
+		return badRequest(9020, "Invalid DNS record type")
+	}
+	obj["type"] = recordType
+
+	if data, ok := obj["data"].(map[string]interface{}); ok && len(data) > 0 {
+		content, name := renderRecordData(recordType, data)
+		if content != "" {
+			obj["content"] = content
+		}
+		if name != "" {
+			obj["name"] = name
+		}
+		if priority, ok := data["priority"]; ok && recordType != "URI" {
+			obj["priority"] = priority
+		}
+	}
+
+	if stringValue(obj["content"]) == "" {
+		return badRequest(9005, "Content for "+recordType+" record is invalid.")
+	}
+	switch content := stringValue(obj["content"]); {
+	case content == "@" && (recordType == "CNAME" || recordType == "MX"):
+		obj["content"] = zoneName
+	default:
+		obj["content"] = canonicalContent(recordType, content)
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(stringValue(obj["name"]), "."))
+	switch {
+	case name == "" || name == "@":
+		name = zoneName
+	case name != zoneName && !strings.HasSuffix(name, "."+zoneName):
+		name = name + "." + zoneName
+	}
+	obj["name"] = name
+
+	proxied, _ := obj["proxied"].(bool)
+	if proxied && !proxiableTypes[recordType] {
+		return badRequest(9004, "This record type cannot be proxied.")
+	}
+	obj["proxied"] = proxied
+	obj["proxiable"] = proxiableTypes[recordType]
+
+	ttl, _ := obj["ttl"].(float64)
+	if ttl == 0 || proxied {
+		ttl = 1
+	}
+	obj["ttl"] = ttl
+
+	obj["locked"] = false
+	obj["zone_id"] = zoneID
+	obj["zone_name"] = zoneName
+	obj["meta"] = map[string]interface{}{
+		"auto_added":             false,
+		"managed_by_apps":        false,
+		"managed_by_argo_tunnel": false,
+	}
+
+	for _, other := range c.list() {
+		if other["id"] == obj["id"] || other["name"] != name {
+			continue
+		}
+		if other["type"] == recordType && other["content"] == obj["content"] {
+			return badRequest(81057, "The record already exists.")
+		}
+		if other["type"] == "CNAME" || recordType == "CNAME" {
+			return badRequest(81053, "An A, AAAA or CNAME record already exists with that host.")
+		}
+	}
+
+	return nil
+}
+
+// canonicalContent formats content the way the API returns it: IP addresses
+// in their shortest form, hostnames in lower case without the trailing dot
+// and TXT values longer than 255 characters split into quoted strings.
+func canonicalContent(recordType, content string) string {
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(content); ip != nil {
+			return ip.String()
+		}
+	case "CNAME", "MX", "NS":
+		if content == "." {
+			return content
+		}
+		return strings.ToLower(strings.TrimSuffix(content, "."))
+	case "TXT", "SPF":
+		if len(content) > 255 && !strings.HasPrefix(content, `"`) {
+			var chunks []string
+			for len(content) > 0 {
+				n := len(content)
+				if n > 255 {
+					n = 255
+				}
+				chunk := strings.Replace(content[:n], `\`, `\\`, -1)
+				chunks = append(chunks, `"`+strings.Replace(chunk, `"`, `\"`, -1)+`"`)
+				content = content[n:]
+			}
+			return strings.Join(chunks, " ")
+		}
+	}
+	return content
+}
+
+// renderRecordData produces the content (and for SRV records, the name) the
+// API derives from a structured data block.
+func renderRecordData(recordType string, data map[string]interface{}) (content, name string) {
+	switch recordType {
+	case "SRV":
+		content = fmt.Sprintf("%v\t%v\t%v", number(data["weight"]), number(data["port"]), data["target"])
+		name = fmt.Sprintf("%v.%v.%v", data["service"], data["proto"], data["name"])
+	case "LOC":
+		content = fmt.Sprintf("%v %v %.3f %v %v %v %.3f %v %.2fm %.2fm %.2fm %.2fm",
+			number(data["lat_degrees"]), number(data["lat_minutes"]), float(data["lat_seconds"]), data["lat_direction"],
+			number(data["long_degrees"]), number(data["long_minutes"]), float(data["long_seconds"]), data["long_direction"],
+			float(data["altitude"]), float(data["size"]), float(data["precision_horz"]), float(data["precision_vert"]))
+	case "CAA":
+		content = fmt.Sprintf("%v %v \"%v\"", number(data["flags"]), data["tag"], data["value"])
+	default:
+		parts := make([]string, 0, len(data))
+		for _, k := range sortedKeys(data) {
+			parts = append(parts, fmt.Sprintf("%v", data[k]))
+		}
+		content = strings.Join(parts, " ")
+	}
+	return content, name
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// number formats a decoded JSON number (or numeric string) without a
+// fractional part when it is integral.
+func number(v interface{}) interface{} {
+	f := float(v)
+	if f == math.Trunc(f) {
+		return int64(f)
+	}
+	return f
+}
+
+func float(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case string:
+		var f float64
+		fmt.Sscanf(n, "%g", &f)
+		return f
+	}
+	return 0
+}
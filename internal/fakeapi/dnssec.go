@@ -0,0 +1,64 @@
+package fakeapi
+
+import (
+	"net/http"
+)
+
+// handleDNSSEC implements a zone's DNSSEC endpoint. Enabling DNSSEC leaves it
+// pending, as the real API does until the DS record is added at the
+// registrar, while disabling it goes through "pending-disabled" first.
+func (s *Server) handleDNSSEC(req *request, zone map[string]interface{}) (interface{}, *resultInfo, error) {
+	zoneID := zone["id"].(string)
+	dnssec, ok := s.dnssec[zoneID]
+	if !ok {
+		dnssec = map[string]interface{}{"status": "disabled", "modified_on": nil}
+		s.dnssec[zoneID] = dnssec
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		if dnssec["status"] == "pending-disabled" {
+			result := copyObject(dnssec)
+			s.dnssec[zoneID] = map[string]interface{}{"status": "disabled", "modified_on": timestamp()}
+			return result, nil, nil
+		}
+		return dnssec, nil, nil
+
+	case http.MethodPatch:
+		in, err := decodeObject(req.body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch in["status"] {
+		case "active":
+			if dnssec["status"] == "disabled" {
+				dnssec = map[string]interface{}{
+					"status":           "pending",
+					"flags":            257,
+					"algorithm":        "13",
+					"key_type":         "ECDSAP256SHA256",
+					"digest_type":      "2",
+					"digest_algorithm": "SHA256",
+					"digest":           "48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+					"ds":               zone["name"].(string) + ". 3600 IN DS 16953 13 2 48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+					"key_tag":          16953,
+					"public_key":       "oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA==",
+				}
+			}
+		case "disabled":
+			if dnssec["status"] != "disabled" {
+				dnssec = copyObject(dnssec)
+				dnssec["status"] = "pending-disabled"
+			}
+		default:
+			return nil, nil, badRequest(1007, "Invalid DNSSEC status")
+		}
+
+		dnssec["modified_on"] = timestamp()
+		s.dnssec[zoneID] = dnssec
+		return dnssec, nil, nil
+	}
+
+	return nil, nil, routeNotFound(req.path)
+}
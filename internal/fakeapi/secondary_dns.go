@@ -0,0 +1,110 @@
+package fakeapi
+
+import (
+	"net/http"
+)
+
+// handleSecondaryDNS implements a zone's incoming and outgoing zone transfer
+// configurations. Each zone has at most one of each, and their peers must be
+// among the peers of the account owning the zone.
+func (s *Server) handleSecondaryDNS(req *request, zone map[string]interface{}, rest []string) (interface{}, *resultInfo, error) {
+	if len(rest) == 0 || (rest[0] != "incoming" && rest[0] != "outgoing") {
+		return nil, nil, routeNotFound(req.path)
+	}
+
+	zoneID := zone["id"].(string)
+	direction := rest[0]
+	key := zoneID + "/" + direction
+	existing, ok := s.transfers[key]
+
+	if len(rest) == 2 && direction == "outgoing" {
+		if !ok {
+			return nil, nil, notFound(1003, "Outgoing zone transfers are not configured for this zone")
+		}
+		switch {
+		case rest[1] == "status" && req.method == http.MethodGet:
+		case rest[1] == "enable" && req.method == http.MethodPost:
+			s.transfersEnabled[zoneID] = true
+		case rest[1] == "disable" && req.method == http.MethodPost:
+			s.transfersEnabled[zoneID] = false
+		default:
+			return nil, nil, routeNotFound(req.path)
+		}
+		if s.transfersEnabled[zoneID] {
+			return "Enabled", nil, nil
+		}
+		return "Disabled", nil, nil
+	}
+	if len(rest) != 1 {
+		return nil, nil, routeNotFound(req.path)
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		if !ok {
+			return nil, nil, notFound(1003, "Zone transfers are not configured for this zone")
+		}
+		return existing, nil, nil
+
+	case http.MethodPost, http.MethodPut:
+		if req.method == http.MethodPost && ok {
+			return nil, nil, badRequest(1061, "Zone transfers are already configured for this zone")
+		}
+		if req.method == http.MethodPut && !ok {
+			return nil, nil, notFound(1003, "Zone transfers are not configured for this zone")
+		}
+
+		in, err := decodeObject(req.body)
+		if err != nil {
+			return nil, nil, err
+		}
+		if name, _ := in["name"].(string); name == "" {
+			return nil, nil, badRequest(1001, "name is required")
+		}
+		peers, _ := in["peers"].([]interface{})
+		if len(peers) == 0 {
+			return nil, nil, badRequest(1001, "at least one peer is required")
+		}
+		accountPeers := s.collection("/accounts/" + s.AccountID + "/secondary_dns/peers")
+		for _, peer := range peers {
+			id, _ := peer.(string)
+			if _, ok := accountPeers.items[id]; !ok {
+				return nil, nil, badRequest(1001, "peer "+id+" does not exist")
+			}
+		}
+
+		now := timestamp()
+		in["id"] = zoneID
+		in["modified_time"] = now
+		if ok {
+			in["created_time"] = existing["created_time"]
+		} else {
+			in["created_time"] = now
+		}
+		if direction == "incoming" {
+			if refresh, _ := in["auto_refresh_seconds"].(float64); refresh == 0 {
+				in["auto_refresh_seconds"] = 86400
+			}
+			in["checked_time"] = now
+			in["soa_serial"] = 2019102401
+		} else {
+			in["soa_serial"] = 0
+			in["last_transferred_time"] = ""
+		}
+
+		s.transfers[key] = in
+		return in, nil, nil
+
+	case http.MethodDelete:
+		if !ok {
+			return nil, nil, notFound(1003, "Zone transfers are not configured for this zone")
+		}
+		delete(s.transfers, key)
+		if direction == "outgoing" {
+			delete(s.transfersEnabled, zoneID)
+		}
+		return map[string]interface{}{"id": zoneID}, nil, nil
+	}
+
+	return nil, nil, routeNotFound(req.path)
+}
@@ -0,0 +1,712 @@
+// Package fakeapi implements a stateful, in-memory fake of the subset of the
+// Cloudflare v4 API that the provider talks to, so acceptance tests can run
+// without network access or real credentials.
+//
+// The fake is intentionally permissive: any well-formed request against a
+// known collection is accepted and echoed back with the server-generated
+// fields (ID, timestamps) filled in. Endpoints with behaviour the provider
+// relies on - zones, DNS records, worker scripts, WAF rules and bulk
+// filter/firewall rule operations - are modelled more closely.
+package fakeapi
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PathPrefix is the path the fake API is served under, matching the real API.
+const PathPrefix = "/client/v4"
+
+// collections lists the path segments that hold a list of objects addressable
+// by ID. Anything under one of these segments is treated as a CRUD collection.
+var collections = map[string]bool{
+	"access_rules":   true,
+	"apps":           true,
+	"custom_pages":   true,
+	"dns_records":    true,
+	"filters":        true,
+	"load_balancers": true,
+	"lockdowns":      true,
+	"members":        true,
+	"monitors":       true,
+	"pagerules":      true,
+	"peers":          true,
+	"policies":       true,
+	"pools":          true,
+	"rate_limits":    true,
+	"routes":         true,
+	"rules":          true,
+	"tsigs":          true,
+	"virtual_dns":    true,
+}
+
+// Server is a fake Cloudflare API backed by an httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	// AccountID is the account that owns every zone created by the fake.
+	AccountID string
+
+	mu       sync.Mutex
+	objects  map[string]*collection
+	scripts  map[string][]byte
+	settings map[string]map[string]interface{}
+	dnssec   map[string]map[string]interface{}
+	zones    *collection
+
+	// transfers holds the zone transfer configurations, keyed by zone ID and
+	// direction, and transfersEnabled whether outgoing transfers are enabled.
+	transfers        map[string]map[string]interface{}
+	transfersEnabled map[string]bool
+
+	// customNS holds the custom nameservers of each account by name, and
+	// zoneCustomNS whether a zone uses them. glueAddresses numbers the glue
+	// addresses assigned to custom nameservers.
+	customNS      map[string]map[string]map[string]interface{}
+	zoneCustomNS  map[string]map[string]interface{}
+	glueAddresses int
+
+	// activating holds the pending zones an activation check was requested
+	// for, which become active once they are read again.
+	activating map[string]bool
+
+	// wafModes holds the modes of the WAF rules of each zone that were
+	// changed from the default.
+	wafModes map[string]map[string]string
+}
+
+type collection struct {
+	ids   []string
+	items map[string]map[string]interface{}
+}
+
+func newCollection() *collection {
+	return &collection{items: make(map[string]map[string]interface{})}
+}
+
+func (c *collection) add(obj map[string]interface{}) {
+	id := obj["id"].(string)
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = obj
+}
+
+func (c *collection) remove(id string) {
+	delete(c.items, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (c *collection) list() []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(c.ids))
+	for _, id := range c.ids {
+		out = append(out, c.items[id])
+	}
+	return out
+}
+
+// NewServer starts a fake API with the given zones already provisioned.
+func NewServer(accountID string, zones ...string) *Server {
+	s := &Server{
+		AccountID: accountID,
+		objects:   make(map[string]*collection),
+		scripts:   make(map[string][]byte),
+		settings:  make(map[string]map[string]interface{}),
+		dnssec:    make(map[string]map[string]interface{}),
+		zones:     newCollection(),
+
+		transfers:        make(map[string]map[string]interface{}),
+		transfersEnabled: make(map[string]bool),
+
+		customNS:     make(map[string]map[string]map[string]interface{}),
+		zoneCustomNS: make(map[string]map[string]interface{}),
+
+		activating: make(map[string]bool),
+		wafModes:   make(map[string]map[string]string),
+	}
+	for _, z := range zones {
+		s.AddZone(z)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// BaseURL returns the URL to configure the provider's api_base_url with.
+func (s *Server) BaseURL() string {
+	return s.URL + PathPrefix
+}
+
+// AddZone provisions an active zone and returns its ID.
+func (s *Server) AddZone(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createZone(name, "full")["id"].(string)
+}
+
+// apiError is a single entry of the "errors" list in the response envelope.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type resultInfo struct {
+	Page       int `json:"page"`
+	PerPage    int `json:"per_page"`
+	TotalPages int `json:"total_pages"`
+	Count      int `json:"count"`
+	Total      int `json:"total_count"`
+}
+
+type envelope struct {
+	Success    bool          `json:"success"`
+	Errors     []apiError    `json:"errors"`
+	Messages   []interface{} `json:"messages"`
+	Result     interface{}   `json:"result"`
+	ResultInfo *resultInfo   `json:"result_info,omitempty"`
+}
+
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	header http.Header
+	body   []byte
+}
+
+// errorResponse is returned by handlers to produce an error envelope.
+type errorResponse struct {
+	status int
+	code   int
+	msg    string
+}
+
+func (e *errorResponse) Error() string {
+	return fmt.Sprintf("%d: %s", e.code, e.msg)
+}
+
+func notFound(code int, msg string) *errorResponse {
+	return &errorResponse{status: http.StatusNotFound, code: code, msg: msg}
+}
+
+func badRequest(code int, msg string) *errorResponse {
+	return &errorResponse{status: http.StatusBadRequest, code: code, msg: msg}
+}
+
+func routeNotFound(path string) *errorResponse {
+	return notFound(7003, fmt.Sprintf("Could not route to %s, perhaps your object identifier is invalid?", path))
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, PathPrefix+"/") {
+		writeError(w, routeNotFound(r.URL.Path))
+		return
+	}
+	if !authenticated(r) {
+		writeError(w, badRequest(6003, "Invalid request headers"))
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, badRequest(6007, "Malformed request body"))
+		return
+	}
+
+	req := &request{
+		method: r.Method,
+		path:   strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, PathPrefix), "/"),
+		query:  r.URL.Query(),
+		header: r.Header,
+		body:   body,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if raw, ok, err := s.handleRaw(req); ok {
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/javascript")
+		w.Write(raw)
+		return
+	}
+
+	result, info, err := s.dispatch(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, envelope{
+		Success:    true,
+		Errors:     []apiError{},
+		Messages:   []interface{}{},
+		Result:     result,
+		ResultInfo: info,
+	})
+}
+
+func authenticated(r *http.Request) bool {
+	if r.Header.Get("X-Auth-Email") != "" && r.Header.Get("X-Auth-Key") != "" {
+		return true
+	}
+	if r.Header.Get("X-Auth-User-Service-Key") != "" {
+		return true
+	}
+	return strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*errorResponse)
+	if !ok {
+		e = &errorResponse{status: http.StatusInternalServerError, code: 10000, msg: err.Error()}
+	}
+	writeJSON(w, e.status, envelope{
+		Success:  false,
+		Errors:   []apiError{{Code: e.code, Message: e.msg}},
+		Messages: []interface{}{},
+	})
+}
+
+func (s *Server) dispatch(req *request) (interface{}, *resultInfo, error) {
+	segments := strings.Split(strings.TrimPrefix(req.path, "/"), "/")
+
+	switch {
+	case req.path == "/filters/validate-expr":
+		return nil, nil, nil
+	case segments[0] == "zones":
+		return s.handleZones(req, segments)
+	case isScriptPath(req.path):
+		return s.handleScript(req, req.path)
+	case segments[0] == "certificates":
+		return s.handleCertificates(req, segments)
+	case len(segments) >= 4 && strings.HasSuffix(req.path, "/dns_analytics/report") && segments[len(segments)-4] == "virtual_dns":
+		clusterPath := strings.Join(segments[:len(segments)-3], "/")
+		return s.handleVirtualDNSAnalytics(req, clusterPath, segments[len(segments)-3])
+	case segments[0] == "accounts" && len(segments) >= 3 && segments[2] == "custom_ns":
+		return s.handleAccountCustomNS(req, segments[1], segments[3:])
+	case segments[0] == "accounts", segments[0] == "organizations", segments[0] == "user":
+		return s.handleCollection(req, segments)
+	}
+	return nil, nil, routeNotFound(req.path)
+}
+
+// handleCollection implements generic CRUD semantics for any path whose
+// parent or final segment is one of the known collections.
+func (s *Server) handleCollection(req *request, segments []string) (interface{}, *resultInfo, error) {
+	last := segments[len(segments)-1]
+	switch {
+	case collections[last]:
+		return s.collectionRequest(req, req.path, segments)
+	case len(segments) > 1 && collections[segments[len(segments)-2]]:
+		return s.itemRequest(req, strings.Join(segments[:len(segments)-1], "/"), last)
+	}
+	return nil, nil, routeNotFound(req.path)
+}
+
+func (s *Server) collection(path string) *collection {
+	path = "/" + strings.TrimPrefix(path, "/")
+	c, ok := s.objects[path]
+	if !ok {
+		c = newCollection()
+		s.objects[path] = c
+	}
+	return c
+}
+
+func (s *Server) collectionRequest(req *request, path string, segments []string) (interface{}, *resultInfo, error) {
+	c := s.collection(path)
+	kind := segments[len(segments)-1]
+
+	switch req.method {
+	case http.MethodGet:
+		items := filterList(c.list(), req.query)
+		return paginate(items, req.query, 100)
+	case http.MethodPost:
+		if isJSONArray(req.body) {
+			var in []map[string]interface{}
+			if err := json.Unmarshal(req.body, &in); err != nil {
+				return nil, nil, badRequest(6007, "Malformed JSON in request body")
+			}
+			out := make([]map[string]interface{}, 0, len(in))
+			for _, obj := range in {
+				created, err := s.create(c, path, kind, obj)
+				if err != nil {
+					return nil, nil, err
+				}
+				out = append(out, created)
+			}
+			return out, nil, nil
+		}
+		obj, err := decodeObject(req.body)
+		if err != nil {
+			return nil, nil, err
+		}
+		created, err := s.create(c, path, kind, obj)
+		return created, nil, err
+	case http.MethodPut:
+		// bulk updates, as used by filters and firewall rules
+		var in []map[string]interface{}
+		if err := json.Unmarshal(req.body, &in); err != nil {
+			return nil, nil, badRequest(6007, "Malformed JSON in request body")
+		}
+		out := make([]map[string]interface{}, 0, len(in))
+		for _, obj := range in {
+			id, _ := obj["id"].(string)
+			updated, err := s.update(c, path, kind, id, obj, false)
+			if err != nil {
+				return nil, nil, err
+			}
+			out = append(out, updated)
+		}
+		return out, nil, nil
+	case http.MethodDelete:
+		// bulk deletes, as used by filters and firewall rules
+		var out []map[string]interface{}
+		for _, ids := range req.query["id"] {
+			for _, id := range strings.Split(ids, ",") {
+				if _, ok := c.items[id]; !ok {
+					return nil, nil, notFound(10001, "not found")
+				}
+				c.remove(id)
+				out = append(out, map[string]interface{}{"id": id})
+			}
+		}
+		return out, nil, nil
+	}
+	return nil, nil, routeNotFound(req.path)
+}
+
+func (s *Server) itemRequest(req *request, path, id string) (interface{}, *resultInfo, error) {
+	c := s.collection(path)
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	kind := segments[len(segments)-1]
+
+	obj, ok := c.items[id]
+	if !ok {
+		return nil, nil, missingItem(kind, req.path)
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return obj, nil, nil
+	case http.MethodPut, http.MethodPatch:
+		in, err := decodeObject(req.body)
+		if err != nil {
+			return nil, nil, err
+		}
+		updated, err := s.update(c, path, kind, id, in, req.method == http.MethodPatch)
+		return updated, nil, err
+	case http.MethodDelete:
+		c.remove(id)
+		return map[string]interface{}{"id": id}, nil, nil
+	}
+	return nil, nil, routeNotFound(req.path)
+}
+
+// missingItem mirrors the error the real API returns for an unknown ID in a
+// given collection.
+func missingItem(kind, path string) *errorResponse {
+	switch kind {
+	case "dns_records":
+		return notFound(81044, "Record does not exist.")
+	case "pagerules":
+		return badRequest(1002, "Invalid Page Rule identifier")
+	case "members":
+		return notFound(1003, "Member not found")
+	}
+	return routeNotFound(path)
+}
+
+func (s *Server) create(c *collection, path, kind string, obj map[string]interface{}) (map[string]interface{}, error) {
+	now := timestamp()
+	obj["id"] = newID()
+	obj["created_on"] = now
+	obj["modified_on"] = now
+
+	if err := s.applyDefaults(c, path, kind, obj, nil); err != nil {
+		return nil, err
+	}
+
+	c.add(obj)
+	return obj, nil
+}
+
+func (s *Server) update(c *collection, path, kind, id string, in map[string]interface{}, merge bool) (map[string]interface{}, error) {
+	existing, ok := c.items[id]
+	if !ok {
+		return nil, missingItem(kind, path)
+	}
+
+	obj := in
+	if merge {
+		obj = copyObject(existing)
+		for k, v := range in {
+			obj[k] = v
+		}
+	}
+	obj["id"] = id
+	obj["created_on"] = existing["created_on"]
+	obj["modified_on"] = timestamp()
+
+	if err := s.applyDefaults(c, path, kind, obj, existing); err != nil {
+		return nil, err
+	}
+
+	c.add(obj)
+	return obj, nil
+}
+
+// applyDefaults fills in the server-side fields a collection's objects carry
+// in real API responses.
+func (s *Server) applyDefaults(c *collection, path, kind string, obj, existing map[string]interface{}) error {
+	switch {
+	case kind == "dns_records":
+		return s.normalizeDNSRecord(c, path, obj)
+	case kind == "apps" && strings.HasSuffix(path, "/access/apps"):
+		if existing != nil {
+			obj["aud"] = existing["aud"]
+			obj["created_at"] = existing["created_at"]
+		} else {
+			obj["aud"] = newID() + newID()
+			obj["created_at"] = obj["created_on"]
+		}
+		obj["updated_at"] = obj["modified_on"]
+	case kind == "pagerules":
+		if _, ok := obj["priority"]; !ok {
+			obj["priority"] = 1
+		}
+		if _, ok := obj["status"]; !ok {
+			obj["status"] = "disabled"
+		}
+		normalizePageRuleTargets(obj)
+	case kind == "load_balancers":
+		if proxied, _ := obj["proxied"].(bool); proxied {
+			delete(obj, "ttl")
+		} else if ttl, _ := obj["ttl"].(float64); ttl == 0 {
+			obj["ttl"] = 30
+		}
+		if policy, _ := obj["steering_policy"].(string); policy == "" {
+			obj["steering_policy"] = "off"
+		}
+	case kind == "rate_limits":
+		normalizeRateLimitMatch(obj)
+	case kind == "filters" && !strings.Contains(path, "/workers/"):
+		if _, ok := obj["paused"]; !ok {
+			obj["paused"] = false
+		}
+	}
+	return nil
+}
+
+// normalizeRateLimitMatch fills in the traffic the API matches when a rate
+// limit leaves its match, or parts of it, out: any request, and any response
+// from the origin whatever its status.
+func normalizeRateLimitMatch(obj map[string]interface{}) {
+	match, _ := obj["match"].(map[string]interface{})
+	if match == nil {
+		match = map[string]interface{}{}
+		obj["match"] = match
+	}
+
+	request, _ := match["request"].(map[string]interface{})
+	if request == nil {
+		request = map[string]interface{}{}
+		match["request"] = request
+	}
+	if methods, _ := request["methods"].([]interface{}); len(methods) == 0 {
+		request["methods"] = []interface{}{"_ALL_"}
+	}
+	if schemes, _ := request["schemes"].([]interface{}); len(schemes) == 0 {
+		request["schemes"] = []interface{}{"_ALL_"}
+	}
+	if pattern, _ := request["url"].(string); pattern == "" {
+		request["url"] = "*"
+	}
+
+	response, _ := match["response"].(map[string]interface{})
+	if response == nil {
+		response = map[string]interface{}{}
+		match["response"] = response
+	}
+	if _, ok := response["status"]; !ok {
+		response["status"] = []interface{}{}
+	}
+	if _, ok := response["origin_traffic"]; !ok {
+		response["origin_traffic"] = true
+	}
+}
+
+// normalizePageRuleTargets adds the trailing slash the API appends to URL
+// patterns that have no path.
+func normalizePageRuleTargets(obj map[string]interface{}) {
+	targets, _ := obj["targets"].([]interface{})
+	for _, t := range targets {
+		constraint, _ := t.(map[string]interface{})["constraint"].(map[string]interface{})
+		value, ok := constraint["value"].(string)
+		if !ok {
+			continue
+		}
+		host := value
+		if i := strings.Index(host, "://"); i >= 0 {
+			host = host[i+3:]
+		}
+		if !strings.Contains(host, "/") {
+			constraint["value"] = value + "/"
+		}
+	}
+}
+
+// filterList applies the simple equality filters the API supports on list
+// endpoints, ignoring pagination and ordering parameters.
+func filterList(items []map[string]interface{}, query url.Values) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if matches(item, query) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+var ignoredListParams = map[string]bool{
+	"page":      true,
+	"per_page":  true,
+	"order":     true,
+	"direction": true,
+	"match":     true,
+}
+
+func matches(item map[string]interface{}, query url.Values) bool {
+	for key, values := range query {
+		if ignoredListParams[key] || len(values) == 0 {
+			continue
+		}
+		v, ok := lookup(item, key)
+		if !ok {
+			continue
+		}
+		if !strings.EqualFold(fmt.Sprintf("%v", v), values[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookup resolves dotted keys such as "account.id" in a decoded object.
+func lookup(obj map[string]interface{}, key string) (interface{}, bool) {
+	parts := strings.Split(key, ".")
+	var cur interface{} = obj
+	for _, p := range parts {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		cur, ok = m[p]
+		if !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func paginate(items []map[string]interface{}, query url.Values, defaultPerPage int) (interface{}, *resultInfo, error) {
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(query.Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	start := (page - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	totalPages := (len(items) + perPage - 1) / perPage
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return items[start:end], &resultInfo{
+		Page:       page,
+		PerPage:    perPage,
+		TotalPages: totalPages,
+		Count:      end - start,
+		Total:      len(items),
+	}, nil
+}
+
+func decodeObject(body []byte) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return obj, nil
+	}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return nil, badRequest(6007, "Malformed JSON in request body")
+	}
+	return obj, nil
+}
+
+func isJSONArray(body []byte) bool {
+	return strings.HasPrefix(strings.TrimSpace(string(body)), "[")
+}
+
+func copyObject(in map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,216 @@
+package fakeapi
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+func newTestClient(t *testing.T, s *Server) *cloudflare.API {
+	client, err := cloudflare.New("fake-api-key", "user@example.com", cloudflare.UsingRetryPolicy(0, 0, 0))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client.BaseURL = s.BaseURL()
+	return client
+}
+
+func TestServer_DNSRecordLifecycle(t *testing.T) {
+	s := NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer s.Close()
+	client := newTestClient(t, s)
+
+	zoneID, err := client.ZoneIDByName("example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	created, err := client.CreateDNSRecord(zoneID, cloudflare.DNSRecord{
+		Type:    "a",
+		Name:    "WWW",
+		Content: "192.0.2.1",
+		Proxied: true,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	record, err := client.DNSRecord(zoneID, created.Result.ID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if record.Name != "www.example.com" || record.Type != "A" || record.TTL != 1 || !record.Proxiable {
+		t.Fatalf("unexpected record: %#v", record)
+	}
+
+	_, err = client.CreateDNSRecord(zoneID, cloudflare.DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1"})
+	if err == nil || !strings.Contains(err.Error(), "81057") {
+		t.Fatalf("expected duplicate record error, got %v", err)
+	}
+
+	if err := client.DeleteDNSRecord(zoneID, record.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err = client.DNSRecord(zoneID, record.ID)
+	if err == nil || !strings.Contains(err.Error(), "HTTP status 404") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestServer_DNSRecordCanonicalContent(t *testing.T) {
+	s := NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer s.Close()
+	client := newTestClient(t, s)
+
+	zoneID, err := client.ZoneIDByName("example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		recordType, content, expected string
+	}{
+		{"AAAA", "2001:0DB8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		{"CNAME", "Target.Example.COM.", "target.example.com"},
+		{"TXT", strings.Repeat("a", 300), `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`},
+		{"TXT", "Mixed Case", "Mixed Case"},
+	}
+	for i, c := range cases {
+		created, err := client.CreateDNSRecord(zoneID, cloudflare.DNSRecord{
+			Type:    c.recordType,
+			Name:    fmt.Sprintf("record-%d", i),
+			Content: c.content,
+		})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if created.Result.Content != c.expected {
+			t.Errorf("%s %q: expected content %q, got %q", c.recordType, c.content, c.expected, created.Result.Content)
+		}
+	}
+}
+
+func TestServer_ZoneSettings(t *testing.T) {
+	s := NewServer("f037e56e89293a057740de681ac9abbe")
+	defer s.Close()
+	client := newTestClient(t, s)
+	zoneID := s.AddZone("example.com")
+
+	_, err := client.UpdateZoneSettings(zoneID, []cloudflare.ZoneSetting{{ID: "brotli", Value: "on"}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	settings, err := client.ZoneSettings(zoneID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(settings.Result) != len(DefaultZoneSettings) {
+		t.Fatalf("expected %d settings, got %d", len(DefaultZoneSettings), len(settings.Result))
+	}
+	for _, setting := range settings.Result {
+		if setting.ID == "brotli" && setting.Value != "on" {
+			t.Fatalf("expected brotli to be on, got %v", setting.Value)
+		}
+	}
+
+	_, err = client.UpdateZoneSettings(zoneID, []cloudflare.ZoneSetting{{ID: "waf", Value: "on"}})
+	if err == nil {
+		t.Fatal("expected an error updating a read only setting")
+	}
+}
+
+func TestServer_WAFRules(t *testing.T) {
+	s := NewServer("f037e56e89293a057740de681ac9abbe")
+	defer s.Close()
+	client := newTestClient(t, s)
+	zoneID := s.AddZone("example.com")
+
+	packages, err := client.ListWAFPackages(zoneID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(packages) != 1 {
+		t.Fatalf("expected 1 WAF package, got %d", len(packages))
+	}
+
+	rule, err := client.UpdateWAFRule(zoneID, packages[0].ID, "100000", "simulate")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if rule.Mode != "simulate" {
+		t.Fatalf("expected mode simulate, got %q", rule.Mode)
+	}
+
+	rule, err = client.WAFRule(zoneID, packages[0].ID, "100000")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if rule.Mode != "simulate" || rule.PackageID != packages[0].ID {
+		t.Fatalf("expected the rule to keep its mode, got %#v", rule)
+	}
+
+	if _, err := client.WAFRule(zoneID, packages[0].ID, "999999"); err == nil {
+		t.Fatal("expected an error reading an unknown rule")
+	}
+	if _, err := client.UpdateWAFRule(zoneID, packages[0].ID, "100000", "bogus"); err == nil {
+		t.Fatal("expected an error setting an invalid mode")
+	}
+}
+
+func TestServer_ListZonesFilters(t *testing.T) {
+	s := NewServer("f037e56e89293a057740de681ac9abbe", "example.com", "example.net", "foo.org")
+	defer s.Close()
+	client := newTestClient(t, s)
+
+	cases := map[string]int{
+		"example.com":          1,
+		"contains:example":     2,
+		"starts_with:foo":      1,
+		"ends_with:.net":       1,
+		"contains:nonexistent": 0,
+	}
+	for name, expected := range cases {
+		zones, err := client.ListZones(name)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if len(zones) != expected {
+			t.Errorf("%s: expected %d zones, got %d", name, expected, len(zones))
+		}
+	}
+}
+
+func TestServer_RequiresAuthentication(t *testing.T) {
+	s := NewServer("f037e56e89293a057740de681ac9abbe", "example.com")
+	defer s.Close()
+	client := newTestClient(t, s)
+	client.APIKey = ""
+
+	if _, err := client.ListZones(); err == nil {
+		t.Fatal("expected an error for an unauthenticated request")
+	}
+}
+
+func TestServer_WorkerScript(t *testing.T) {
+	s := NewServer("f037e56e89293a057740de681ac9abbe")
+	defer s.Close()
+	client := newTestClient(t, s)
+	zoneID := s.AddZone("example.com")
+	params := &cloudflare.WorkerRequestParams{ZoneID: zoneID}
+
+	if _, err := client.UploadWorker(params, "addEventListener('fetch', e => {})"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	script, err := client.DownloadWorker(params)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if script.Script != "addEventListener('fetch', e => {})" {
+		t.Fatalf("unexpected script %q", script.Script)
+	}
+}
@@ -0,0 +1,132 @@
+package fakeapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// virtualDNSMetrics are the metrics of the DNS analytics of a virtual DNS
+// cluster, with the value each reports for the first row of a report.
+var virtualDNSMetrics = map[string]float64{
+	"queryCount":         12000,
+	"uncachedCount":      3000,
+	"staleCount":         60,
+	"responseTimeAvg":    12.5,
+	"responseTimeMedian": 8,
+	"responseTime90th":   30,
+	"responseTime99th":   95,
+}
+
+// virtualDNSDimensionValues are the values reported for each dimension of a
+// report, anything else reports a single empty value.
+var virtualDNSDimensionValues = map[string][]string{
+	"queryName":    {"example.com", "www.example.com"},
+	"queryType":    {"A", "AAAA", "MX"},
+	"responseCode": {"NOERROR", "NXDOMAIN"},
+	"coloName":     {"AMS", "SJC"},
+}
+
+// handleVirtualDNSAnalytics implements the DNS analytics report of a virtual
+// DNS cluster. The report is made up, but the same query always returns the
+// same numbers, with a row for each combination of dimension values.
+func (s *Server) handleVirtualDNSAnalytics(req *request, clusterPath, clusterID string) (interface{}, *resultInfo, error) {
+	if req.method != http.MethodGet {
+		return nil, nil, routeNotFound(req.path)
+	}
+	if _, ok := s.collection(clusterPath).items[clusterID]; !ok {
+		return nil, nil, routeNotFound(req.path)
+	}
+
+	var metrics, dimensions []string
+	if m := req.query.Get("metrics"); m != "" {
+		metrics = strings.Split(m, ",")
+	}
+	if dims := req.query.Get("dimensions"); dims != "" {
+		dimensions = strings.Split(dims, ",")
+	}
+	if len(metrics) == 0 {
+		return nil, nil, badRequest(1001, "at least one metric is required")
+	}
+	for _, metric := range metrics {
+		if _, ok := virtualDNSMetrics[metric]; !ok {
+			return nil, nil, badRequest(1001, "unknown metric "+metric)
+		}
+	}
+
+	until := time.Now().UTC().Truncate(time.Minute)
+	since := until.Add(-6 * time.Hour)
+	for key, t := range map[string]*time.Time{"since": &since, "until": &until} {
+		if v := req.query.Get(key); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, nil, badRequest(1001, "invalid "+key+" time")
+			}
+			*t = parsed
+		}
+	}
+	if !since.Before(until) {
+		return nil, nil, badRequest(1001, "since must be before until")
+	}
+
+	combinations := [][]string{{}}
+	for _, dimension := range dimensions {
+		values, ok := virtualDNSDimensionValues[dimension]
+		if !ok {
+			values = []string{""}
+		}
+		var next [][]string
+		for _, combination := range combinations {
+			for _, value := range values {
+				next = append(next, append(append([]string{}, combination...), value))
+			}
+		}
+		combinations = next
+	}
+
+	totals := make(map[string]float64, len(metrics))
+	min := make(map[string]float64, len(metrics))
+	max := make(map[string]float64, len(metrics))
+	data := make([]map[string]interface{}, 0, len(combinations))
+	for i, combination := range combinations {
+		values := make([]float64, 0, len(metrics))
+		for _, metric := range metrics {
+			value := virtualDNSMetrics[metric] / float64(i+1)
+			values = append(values, value)
+
+			if strings.HasSuffix(metric, "Count") {
+				totals[metric] += value
+			} else {
+				totals[metric] += value / float64(len(combinations))
+			}
+			if i == 0 || value < min[metric] {
+				min[metric] = value
+			}
+			if i == 0 || value > max[metric] {
+				max[metric] = value
+			}
+		}
+		if len(dimensions) > 0 {
+			data = append(data, map[string]interface{}{"dimensions": combination, "metrics": values})
+		}
+	}
+
+	if limit, _ := strconv.Atoi(req.query.Get("limit")); limit > 0 && limit < len(data) {
+		data = data[:limit]
+	}
+
+	return map[string]interface{}{
+		"rows":   len(data),
+		"data":   data,
+		"totals": totals,
+		"min":    min,
+		"max":    max,
+		"query": map[string]interface{}{
+			"dimensions": dimensions,
+			"metrics":    metrics,
+			"since":      since.Format(time.RFC3339),
+			"until":      until.Format(time.RFC3339),
+		},
+	}, nil, nil
+}
@@ -0,0 +1,109 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+)
+
+// wafPackageID is the ID of the one WAF package of every zone, the Cloudflare
+// managed ruleset.
+const wafPackageID = "a25a9a7e9c00afc1fb2e0245519d725b"
+
+// wafRules are the rules of the WAF package, by ID.
+var wafRules = map[string]map[string]interface{}{
+	"100000": {
+		"description": "Block requests with non-ASCII characters in the URI",
+		"priority":    "5",
+		"group":       map[string]interface{}{"id": "de677e5818985db1285d0e80225f06e5", "name": "Cloudflare Specials"},
+	},
+	"100001": {
+		"description": "Anomalous Host header",
+		"priority":    "5",
+		"group":       map[string]interface{}{"id": "de677e5818985db1285d0e80225f06e5", "name": "Cloudflare Specials"},
+	},
+}
+
+var wafRuleModes = []interface{}{"default", "disable", "simulate", "block", "challenge"}
+
+// handleWAF implements a zone's WAF packages and the rules of its package,
+// whose mode is the only thing that can be changed.
+func (s *Server) handleWAF(req *request, zone map[string]interface{}, segments []string) (interface{}, *resultInfo, error) {
+	zoneID := zone["id"].(string)
+
+	if len(segments) == 0 && req.method == http.MethodGet {
+		return []interface{}{map[string]interface{}{
+			"id":             wafPackageID,
+			"name":           "CloudFlare",
+			"description":    "Cloudflare Managed Ruleset",
+			"zone_id":        zoneID,
+			"detection_mode": "traditional",
+			"sensitivity":    nil,
+			"action_mode":    nil,
+		}}, nil, nil
+	}
+	if len(segments) < 2 || segments[0] != wafPackageID || segments[1] != "rules" {
+		return nil, nil, routeNotFound(req.path)
+	}
+
+	modes, ok := s.wafModes[zoneID]
+	if !ok {
+		modes = make(map[string]string)
+		s.wafModes[zoneID] = modes
+	}
+	rule := func(id string) map[string]interface{} {
+		obj := copyObject(wafRules[id])
+		obj["id"] = id
+		obj["package_id"] = wafPackageID
+		obj["mode"] = "default"
+		if mode, ok := modes[id]; ok {
+			obj["mode"] = mode
+		}
+		obj["default_mode"] = "block"
+		obj["allowed_modes"] = wafRuleModes
+		return obj
+	}
+
+	if len(segments) == 2 {
+		if req.method != http.MethodGet {
+			return nil, nil, routeNotFound(req.path)
+		}
+		ids := make([]string, 0, len(wafRules))
+		for id := range wafRules {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		out := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, rule(id))
+		}
+		return out, nil, nil
+	}
+
+	id := segments[2]
+	if _, ok := wafRules[id]; !ok || len(segments) > 3 {
+		return nil, nil, notFound(1002, "Invalid rule identifier")
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return rule(id), nil, nil
+	case http.MethodPatch:
+		in, err := decodeObject(req.body)
+		if err != nil {
+			return nil, nil, err
+		}
+		mode, _ := in["mode"].(string)
+		valid := false
+		for _, m := range wafRuleModes {
+			valid = valid || m == mode
+		}
+		if !valid {
+			return nil, nil, badRequest(1003, "Invalid mode "+mode)
+		}
+		modes[id] = mode
+		return rule(id), nil, nil
+	}
+
+	return nil, nil, routeNotFound(req.path)
+}
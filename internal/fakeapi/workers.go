@@ -0,0 +1,52 @@
+package fakeapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// handleRaw serves worker script downloads, the only endpoints that answer
+// with a raw body rather than the JSON envelope.
+func (s *Server) handleRaw(req *request) ([]byte, bool, error) {
+	if req.method != http.MethodGet || !isScriptPath(req.path) {
+		return nil, false, nil
+	}
+	script, ok := s.scripts[req.path]
+	if !ok {
+		return nil, true, notFound(10007, "workers.api.error.script_not_found")
+	}
+	return script, true, nil
+}
+
+func isScriptPath(path string) bool {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	switch {
+	case len(segments) == 4 && segments[0] == "zones" && segments[2] == "workers" && segments[3] == "script":
+		return true
+	case len(segments) == 5 && segments[0] == "accounts" && segments[2] == "workers" && segments[3] == "scripts":
+		return true
+	}
+	return false
+}
+
+func (s *Server) handleScript(req *request, path string) (interface{}, *resultInfo, error) {
+	switch req.method {
+	case http.MethodPut:
+		s.scripts[path] = req.body
+		return map[string]interface{}{
+			"id":          path[strings.LastIndex(path, "/")+1:],
+			"script":      string(req.body),
+			"etag":        newID(),
+			"size":        len(req.body),
+			"modified_on": time.Now().UTC().Format(time.RFC3339Nano),
+		}, nil, nil
+	case http.MethodDelete:
+		if _, ok := s.scripts[path]; !ok {
+			return nil, nil, notFound(10007, "workers.api.error.script_not_found")
+		}
+		delete(s.scripts, path)
+		return nil, nil, nil
+	}
+	return nil, nil, routeNotFound(req.path)
+}
@@ -0,0 +1,409 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// plans are the rate plans every fake zone can subscribe to, keyed by the
+// legacy ID the provider uses to refer to them.
+var plans = []map[string]interface{}{
+	{"id": "0feeeeeeeeeeeeeeeeeeeeeeeeeeeeee", "name": "Free Website", "price": 0, "currency": "USD", "frequency": "", "legacy_id": "free", "can_subscribe": true},
+	{"id": "94f3b7b768b0458b56d2cac4fe5ec0f9", "name": "Pro Website", "price": 20, "currency": "USD", "frequency": "monthly", "legacy_id": "pro", "can_subscribe": true},
+	{"id": "6d9b8e3a9b1a4e0bb8d6f3f1c0b9d9d5", "name": "Business Website", "price": 200, "currency": "USD", "frequency": "monthly", "legacy_id": "business", "can_subscribe": true},
+	{"id": "a577b510288e82b26486fa1c64f2d86c", "name": "Enterprise Website", "price": 0, "currency": "USD", "frequency": "monthly", "legacy_id": "enterprise", "can_subscribe": true},
+}
+
+// DefaultZoneSettings are the settings a newly created fake zone starts with,
+// mirroring the values the real API reports for a fresh free zone.
+var DefaultZoneSettings = map[string]interface{}{
+	"0rtt":                     "off",
+	"advanced_ddos":            "on",
+	"always_online":            "on",
+	"always_use_https":         "off",
+	"automatic_https_rewrites": "off",
+	"automatic_platform_optimization": map[string]interface{}{
+		"enabled": false, "cf": false, "wordpress": false, "wp_plugin": false, "hostnames": []interface{}{}, "cache_by_device_type": false,
+	},
+	"brotli":                      "off",
+	"browser_cache_ttl":           14400,
+	"browser_check":               "on",
+	"cache_level":                 "aggressive",
+	"challenge_ttl":               1800,
+	"ciphers":                     []interface{}{},
+	"cname_flattening":            "flatten_at_root",
+	"development_mode":            "off",
+	"edge_cache_ttl":              7200,
+	"early_hints":                 "off",
+	"email_obfuscation":           "on",
+	"filter_logs_to_cloudflare":   "off",
+	"h2_prioritization":           "off",
+	"hotlink_protection":          "off",
+	"http2":                       "on",
+	"http3":                       "off",
+	"image_resizing":              "off",
+	"ip_geolocation":              "on",
+	"ipv6":                        "off",
+	"log_to_cloudflare":           "on",
+	"max_upload":                  100,
+	"min_tls_version":             "1.0",
+	"minify":                      map[string]interface{}{"css": "off", "html": "off", "js": "off"},
+	"mirage":                      "off",
+	"mobile_redirect":             map[string]interface{}{"status": "off", "mobile_subdomain": nil, "strip_uri": false},
+	"nel":                         map[string]interface{}{"enabled": false},
+	"opportunistic_encryption":    "on",
+	"opportunistic_onion":         "on",
+	"orange_to_orange":            "off",
+	"origin_error_page_pass_thru": "off",
+	"origin_max_http_version":     "2",
+	"polish":                      "off",
+	"prefetch_preload":            "off",
+	"privacy_pass":                "on",
+	"proxy_read_timeout":          100,
+	"pseudo_ipv4":                 "off",
+	"response_buffering":          "off",
+	"rocket_loader":               "off",
+	"security_header": map[string]interface{}{"strict_transport_security": map[string]interface{}{
+		"enabled": false, "max_age": 0, "include_subdomains": false, "nosniff": false, "preload": false,
+	}},
+	"security_level":              "medium",
+	"server_side_exclude":         "on",
+	"sha1_support":                "off",
+	"sort_query_string_for_cache": "off",
+	"ssl":                         "flexible",
+	"tls_1_2_only":                "off",
+	"tls_1_3":                     "on",
+	"tls_client_auth":             "off",
+	"true_client_ip_header":       "off",
+	"visitor_ip":                  "on",
+	"waf":                         "off",
+	"webp":                        "off",
+	"websockets":                  "on",
+}
+
+// settingPlans are the plans, by legacy ID, that settings become editable
+// on. Settings that aren't listed are editable on every plan.
+var settingPlans = map[string]string{
+	"advanced_ddos":               "business",
+	"h2_prioritization":           "pro",
+	"image_resizing":              "business",
+	"mirage":                      "pro",
+	"polish":                      "pro",
+	"waf":                         "pro",
+	"webp":                        "pro",
+	"filter_logs_to_cloudflare":   "enterprise",
+	"log_to_cloudflare":           "enterprise",
+	"orange_to_orange":            "enterprise",
+	"origin_error_page_pass_thru": "enterprise",
+	"origin_max_http_version":     "enterprise",
+	"prefetch_preload":            "enterprise",
+	"proxy_read_timeout":          "enterprise",
+	"sort_query_string_for_cache": "enterprise",
+	"true_client_ip_header":       "enterprise",
+}
+
+// settingEditable reports whether the plan of zone allows changing the
+// setting id. plans are ordered from the cheapest to the most expensive.
+func settingEditable(zone map[string]interface{}, id string) bool {
+	required, ok := settingPlans[id]
+	if !ok {
+		return true
+	}
+
+	current := zone["plan"].(map[string]interface{})["legacy_id"]
+	for _, plan := range plans {
+		if plan["legacy_id"] == required {
+			return true
+		}
+		if plan["legacy_id"] == current {
+			return false
+		}
+	}
+	return false
+}
+
+func (s *Server) createZone(name, zoneType string) map[string]interface{} {
+	now := timestamp()
+	settings := make(map[string]interface{}, len(DefaultZoneSettings))
+	for k, v := range DefaultZoneSettings {
+		settings[k] = v
+	}
+
+	zone := map[string]interface{}{
+		"id":                    newID(),
+		"name":                  strings.ToLower(name),
+		"status":                "active",
+		"paused":                false,
+		"type":                  zoneType,
+		"development_mode":      0,
+		"name_servers":          defaultNameServers,
+		"original_name_servers": []string{"ns1.example.net", "ns2.example.net"},
+		"original_registrar":    nil,
+		"original_dnshost":      nil,
+		"vanity_name_servers":   []string{},
+		"created_on":            now,
+		"modified_on":           now,
+		"activated_on":          now,
+		"owner":                 map[string]interface{}{"id": s.AccountID, "type": "organization", "name": "Fake Account"},
+		"account":               map[string]interface{}{"id": s.AccountID, "name": "Fake Account"},
+		"permissions":           []string{"#zone:read", "#zone:edit"},
+		"plan":                  plans[0],
+		"meta": map[string]interface{}{
+			"page_rule_quota":    3,
+			"wildcard_proxiable": false,
+			"phishing_detected":  false,
+		},
+	}
+	if zoneType == "partial" {
+		zone["verification_key"] = newID()[:10] + "-" + newID()[:10]
+	}
+	s.zones.add(zone)
+	s.settings[zone["id"].(string)] = settings
+	return zone
+}
+
+// zoneNameOperators are the prefixes of a name filter of the zone listing
+// that match part of the name instead of all of it.
+var zoneNameOperators = map[string]func(name, value string) bool{
+	"contains:":    strings.Contains,
+	"starts_with:": strings.HasPrefix,
+	"ends_with:":   strings.HasSuffix,
+}
+
+func (s *Server) listZones(req *request) (interface{}, *resultInfo, error) {
+	query := make(url.Values, len(req.query))
+	for k, v := range req.query {
+		query[k] = v
+	}
+
+	zones := s.zones.list()
+	name := strings.ToLower(query.Get("name"))
+	for prefix, match := range zoneNameOperators {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		query.Del("name")
+
+		var matched []map[string]interface{}
+		for _, zone := range zones {
+			if match(zone["name"].(string), strings.TrimPrefix(name, prefix)) {
+				matched = append(matched, zone)
+			}
+		}
+		zones = matched
+	}
+
+	return paginate(filterList(zones, query), query, 20)
+}
+
+func (s *Server) handleZones(req *request, segments []string) (interface{}, *resultInfo, error) {
+	if len(segments) == 1 {
+		switch req.method {
+		case http.MethodGet:
+			return s.listZones(req)
+		case http.MethodPost:
+			return s.postZone(req)
+		}
+		return nil, nil, routeNotFound(req.path)
+	}
+
+	zoneID := segments[1]
+	zone, ok := s.zones.items[zoneID]
+	if !ok {
+		return nil, nil, routeNotFound(req.path)
+	}
+
+	if len(segments) == 2 {
+		switch req.method {
+		case http.MethodGet:
+			if s.activating[zoneID] {
+				result := copyObject(zone)
+				zone["status"] = "active"
+				zone["activated_on"] = timestamp()
+				delete(s.activating, zoneID)
+				return result, nil, nil
+			}
+			return zone, nil, nil
+		case http.MethodPatch:
+			return s.patchZone(zone, req)
+		case http.MethodDelete:
+			s.zones.remove(zoneID)
+			delete(s.settings, zoneID)
+			delete(s.dnssec, zoneID)
+			delete(s.transfers, zoneID+"/incoming")
+			delete(s.transfers, zoneID+"/outgoing")
+			delete(s.transfersEnabled, zoneID)
+			delete(s.zoneCustomNS, zoneID)
+			delete(s.activating, zoneID)
+			delete(s.wafModes, zoneID)
+			for path := range s.objects {
+				if strings.HasPrefix(path, "/zones/"+zoneID+"/") {
+					delete(s.objects, path)
+				}
+			}
+			return map[string]interface{}{"id": zoneID}, nil, nil
+		}
+		return nil, nil, routeNotFound(req.path)
+	}
+
+	switch segments[2] {
+	case "available_plans", "available_rate_plans":
+		return plans, nil, nil
+	case "activation_check":
+		if req.method != http.MethodPut {
+			return nil, nil, routeNotFound(req.path)
+		}
+		if zone["status"] == "pending" {
+			s.activating[zoneID] = true
+		}
+		return map[string]interface{}{"id": zoneID}, nil, nil
+	case "settings":
+		return s.handleZoneSettings(req, zoneID, segments[3:])
+	case "dnssec":
+		return s.handleDNSSEC(req, zone)
+	case "firewall":
+		if len(segments) >= 5 && segments[3] == "waf" && segments[4] == "packages" {
+			return s.handleWAF(req, zone, segments[5:])
+		}
+	case "secondary_dns":
+		return s.handleSecondaryDNS(req, zone, segments[3:])
+	case "custom_ns":
+		if len(segments) == 3 {
+			return s.handleZoneCustomNS(req, zone)
+		}
+	case "workers":
+		if len(segments) == 4 && segments[3] == "script" {
+			return s.handleScript(req, req.path)
+		}
+		if len(segments) >= 4 && segments[3] == "routes" {
+			// the single- and multi-script APIs share the same routes
+			segments[3] = "filters"
+			req.path = "/" + strings.Join(segments, "/")
+		}
+	}
+
+	return s.handleCollection(req, segments)
+}
+
+func (s *Server) postZone(req *request) (interface{}, *resultInfo, error) {
+	var in struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(req.body, &in); err != nil || in.Name == "" {
+		return nil, nil, badRequest(1001, "Invalid or missing zone name.")
+	}
+	for _, z := range s.zones.list() {
+		if z["name"] == strings.ToLower(in.Name) {
+			return nil, nil, badRequest(1061, in.Name+" already exists")
+		}
+	}
+	switch in.Type {
+	case "":
+		in.Type = "full"
+	case "full", "partial", "secondary":
+	default:
+		return nil, nil, badRequest(1001, "Invalid zone type "+in.Type)
+	}
+
+	zone := s.createZone(in.Name, in.Type)
+	zone["status"] = "pending"
+	zone["activated_on"] = nil
+	return zone, nil, nil
+}
+
+func (s *Server) patchZone(zone map[string]interface{}, req *request) (interface{}, *resultInfo, error) {
+	in, err := decodeObject(req.body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if paused, ok := in["paused"]; ok {
+		zone["paused"] = paused
+	}
+	if ns, ok := in["vanity_name_servers"]; ok {
+		zone["vanity_name_servers"] = ns
+	}
+	if plan, ok := in["plan"].(map[string]interface{}); ok {
+		found := false
+		for _, p := range plans {
+			if p["id"] == plan["id"] {
+				zone["plan"] = p
+				found = true
+			}
+		}
+		if !found {
+			return nil, nil, badRequest(1004, "Invalid plan identifier")
+		}
+	}
+	zone["modified_on"] = timestamp()
+	return zone, nil, nil
+}
+
+func (s *Server) handleZoneSettings(req *request, zoneID string, rest []string) (interface{}, *resultInfo, error) {
+	values := s.settings[zoneID]
+	zone := s.zones.items[zoneID]
+
+	setting := func(id string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":          id,
+			"value":       values[id],
+			"editable":    settingEditable(zone, id),
+			"modified_on": nil,
+		}
+	}
+
+	if len(rest) == 1 {
+		id := rest[0]
+		if _, ok := values[id]; !ok {
+			return nil, nil, notFound(1003, "Invalid zone setting "+id)
+		}
+		if req.method == http.MethodPatch {
+			in, err := decodeObject(req.body)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !settingEditable(zone, id) {
+				return nil, nil, badRequest(1007, "Zone setting "+id+" is read only")
+			}
+			values[id] = in["value"]
+		}
+		return setting(id), nil, nil
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		out := make([]map[string]interface{}, 0, len(values))
+		for _, id := range sortedKeys(values) {
+			out = append(out, setting(id))
+		}
+		return out, nil, nil
+	case http.MethodPatch:
+		var in struct {
+			Items []struct {
+				ID    string      `json:"id"`
+				Value interface{} `json:"value"`
+			} `json:"items"`
+		}
+		if err := json.Unmarshal(req.body, &in); err != nil {
+			return nil, nil, badRequest(6007, "Malformed JSON in request body")
+		}
+		for _, item := range in.Items {
+			if _, ok := values[item.ID]; !ok {
+				return nil, nil, badRequest(1006, "Unrecognized zone setting name")
+			}
+			if !settingEditable(zone, item.ID) {
+				return nil, nil, badRequest(1007, "Zone setting "+item.ID+" is read only")
+			}
+		}
+		out := make([]map[string]interface{}, 0, len(in.Items))
+		for _, item := range in.Items {
+			values[item.ID] = item.Value
+			out = append(out, setting(item.ID))
+		}
+		return out, nil, nil
+	}
+	return nil, nil, routeNotFound(req.path)
+}